@@ -0,0 +1,293 @@
+package anomaly
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// sweepInterval is how often the state of idle users is dropped.
+const sweepInterval = time.Minute
+
+// Sample is a numeric wearable reading being ingested.
+type Sample struct {
+	UserID     string
+	DataType   string
+	DeviceType string
+	Value      float64
+	RecordedAt time.Time
+}
+
+type point struct {
+	at    time.Time
+	value float64
+}
+
+// series holds the recent samples of a user and data type, for rate of change
+// rules.
+type series struct {
+	points []point
+	seen   time.Time
+}
+
+// ruleState is the state of a rule for a user.
+type ruleState struct {
+	// breachSince is the time of the first sample of the current run of
+	// samples beyond the threshold.
+	breachSince time.Time
+	lastAlert   time.Time
+	seen        time.Time
+}
+
+type seriesKey struct{ userID, dataType string }
+
+type stateKey struct{ userID, rule string }
+
+// Detector evaluates ingested samples against the anomaly rules. The recent
+// samples of each user are kept in process memory, so duration and rate of
+// change rules only see the samples ingested by this gateway instance.
+type Detector struct {
+	rules     *RuleSet
+	retention time.Duration
+
+	mu     sync.Mutex
+	series map[seriesKey]*series
+	states map[stateKey]*ruleState
+	now    func() time.Time
+	done   chan struct{}
+}
+
+// NewDetector creates a Detector with the rules file of cfg, or returns nil
+// when anomaly detection is disabled.
+func NewDetector(cfg config.Config) (*Detector, error) {
+	if !cfg.AnomalyDetectionEnabled {
+		return nil, nil
+	}
+
+	rules, err := LoadRules(cfg.AnomalyRulesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Detector{
+		rules:     rules,
+		retention: rules.retention(),
+		series:    make(map[seriesKey]*series),
+		states:    make(map[stateKey]*ruleState),
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}
+	go d.sweep()
+	return d, nil
+}
+
+// Evaluate records sample and returns the alerts it raises.
+func (d *Detector) Evaluate(sample Sample) []*health.WearableAlert {
+	if d == nil {
+		return nil
+	}
+	rules := d.rules.For(sample.UserID, sample.DataType)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	s := d.seriesOf(sample, rules, now)
+
+	var alerts []*health.WearableAlert
+	for _, rule := range rules {
+		key := stateKey{userID: sample.UserID, rule: rule.Name}
+		state, ok := d.states[key]
+		if !ok {
+			state = &ruleState{}
+			d.states[key] = state
+		}
+		state.seen = now
+
+		if !rule.matches(state, s, sample) {
+			continue
+		}
+		if !state.lastAlert.IsZero() && absDuration(sample.RecordedAt.Sub(state.lastAlert)) < time.Duration(rule.Dedup) {
+			continue
+		}
+		state.lastAlert = sample.RecordedAt
+
+		alerts = append(alerts, &health.WearableAlert{
+			Id:                uuid.NewString(),
+			UserId:            sample.UserID,
+			Rule:              rule.Name,
+			Severity:          rule.Severity,
+			DataType:          sample.DataType,
+			DeviceType:        sample.DeviceType,
+			Value:             sample.Value,
+			Threshold:         rule.Value,
+			Message:           rule.describe(),
+			RecordedTimestamp: sample.RecordedAt.Format(time.RFC3339),
+			TriggeredAt:       now.UTC().Format(time.RFC3339),
+		})
+	}
+
+	s.points = append(s.points, point{at: sample.RecordedAt, value: sample.Value})
+	return alerts
+}
+
+// Close stops dropping idle state.
+func (d *Detector) Close() error {
+	if d == nil {
+		return nil
+	}
+	close(d.done)
+	return nil
+}
+
+// seriesOf returns the series of sample, without the points older than the
+// longest window of rules.
+func (d *Detector) seriesOf(sample Sample, rules []Rule, now time.Time) *series {
+	key := seriesKey{userID: sample.UserID, dataType: sample.DataType}
+	s, ok := d.series[key]
+	if !ok {
+		s = &series{}
+		d.series[key] = s
+	}
+	s.seen = now
+
+	var window time.Duration
+	for _, rule := range rules {
+		if rule.Kind == KindRateOfChange {
+			window = max(window, time.Duration(rule.Within))
+		}
+	}
+	kept := s.points[:0]
+	for _, p := range s.points {
+		if sample.RecordedAt.Sub(p.at) <= window {
+			kept = append(kept, p)
+		}
+	}
+	s.points = kept
+
+	return s
+}
+
+func (d *Detector) sweep() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+			d.mu.Lock()
+			idle := d.now().Add(-d.retention)
+			for key, s := range d.series {
+				if s.seen.Before(idle) {
+					delete(d.series, key)
+				}
+			}
+			for key, state := range d.states {
+				if state.seen.Before(idle) {
+					delete(d.states, key)
+				}
+			}
+			d.mu.Unlock()
+		}
+	}
+}
+
+// matches reports whether sample matches the rule, updating its state.
+func (r Rule) matches(state *ruleState, s *series, sample Sample) bool {
+	if r.Kind == KindRateOfChange {
+		for _, p := range s.points {
+			if absDuration(sample.RecordedAt.Sub(p.at)) > time.Duration(r.Within) {
+				continue
+			}
+			rise := sample.Value - p.value
+			if (r.Operator != "<" && rise >= r.Value) || (r.Operator != ">" && -rise >= r.Value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !r.breached(sample.Value) {
+		state.breachSince = time.Time{}
+		return false
+	}
+	if state.breachSince.IsZero() || sample.RecordedAt.Before(state.breachSince) {
+		state.breachSince = sample.RecordedAt
+	}
+	return sample.RecordedAt.Sub(state.breachSince) >= time.Duration(r.For)
+}
+
+func (r Rule) breached(value float64) bool {
+	switch r.Operator {
+	case ">":
+		return value > r.Value
+	case ">=":
+		return value >= r.Value
+	case "<":
+		return value < r.Value
+	default:
+		return value <= r.Value
+	}
+}
+
+// describe returns the condition of the rule in words.
+func (r Rule) describe() string {
+	if r.Kind == KindRateOfChange {
+		change := "changed"
+		switch r.Operator {
+		case ">":
+			change = "rose"
+		case "<":
+			change = "fell"
+		}
+		return fmt.Sprintf("%s %s by at least %g within %s", r.DataType, change, r.Value, time.Duration(r.Within))
+	}
+
+	condition := fmt.Sprintf("%s %s %g", r.DataType, r.Operator, r.Value)
+	if r.For > 0 {
+		condition += " for " + time.Duration(r.For).String()
+	}
+	return condition
+}
+
+// retention returns how long the state of a user matters to the rules.
+func (s *RuleSet) retention() time.Duration {
+	longest := time.Hour
+	for _, rules := range s.all() {
+		for _, rule := range rules {
+			longest = max(longest, time.Duration(rule.For), time.Duration(rule.Within), time.Duration(rule.Dedup))
+		}
+	}
+	return longest
+}
+
+// all returns the rules of every data type, including the user overrides.
+func (s *RuleSet) all() [][]Rule {
+	var all [][]Rule
+	for _, rules := range s.rules {
+		all = append(all, rules)
+	}
+	for _, users := range s.users {
+		for _, rules := range users {
+			all = append(all, rules)
+		}
+	}
+	return all
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,157 @@
+package anomaly
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+var t0 = time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+
+// step is a sample value recorded at an offset from t0.
+type step struct {
+	after time.Duration
+	value float64
+}
+
+func TestRuleMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  Rule
+		steps []step
+		// want holds whether each step matches.
+		want []bool
+	}{
+		{
+			name:  "threshold",
+			rule:  Rule{Kind: KindThreshold, Operator: "<", Value: 90},
+			steps: []step{{0, 95}, {time.Minute, 89}, {2 * time.Minute, 90}},
+			want:  []bool{false, true, false},
+		},
+		{
+			name:  "inclusive threshold",
+			rule:  Rule{Kind: KindThreshold, Operator: "<=", Value: 90},
+			steps: []step{{0, 90}, {time.Minute, 91}},
+			want:  []bool{true, false},
+		},
+		{
+			name: "threshold held for a duration",
+			rule: Rule{Kind: KindThreshold, Operator: ">", Value: 120, For: Duration(10 * time.Minute)},
+			steps: []step{
+				{0, 130}, {5 * time.Minute, 125}, {10 * time.Minute, 140}, {11 * time.Minute, 121},
+			},
+			want: []bool{false, false, true, true},
+		},
+		{
+			name: "duration restarts after a normal sample",
+			rule: Rule{Kind: KindThreshold, Operator: ">", Value: 120, For: Duration(10 * time.Minute)},
+			steps: []step{
+				{0, 130}, {5 * time.Minute, 100}, {10 * time.Minute, 140}, {19 * time.Minute, 140}, {20 * time.Minute, 140},
+			},
+			want: []bool{false, false, false, false, true},
+		},
+		{
+			name: "late sample extends the run back",
+			rule: Rule{Kind: KindThreshold, Operator: ">", Value: 120, For: Duration(10 * time.Minute)},
+			steps: []step{
+				{10 * time.Minute, 130}, {0, 130}, {10 * time.Minute, 130},
+			},
+			want: []bool{false, false, true},
+		},
+		{
+			name:  "rise",
+			rule:  Rule{Kind: KindRateOfChange, Operator: ">", Value: 40, Within: Duration(5 * time.Minute)},
+			steps: []step{{0, 70}, {time.Minute, 100}, {2 * time.Minute, 115}, {3 * time.Minute, 60}},
+			want:  []bool{false, false, true, false},
+		},
+		{
+			name:  "fall",
+			rule:  Rule{Kind: KindRateOfChange, Operator: "<", Value: 40, Within: Duration(5 * time.Minute)},
+			steps: []step{{0, 120}, {time.Minute, 160}, {2 * time.Minute, 75}},
+			want:  []bool{false, false, true},
+		},
+		{
+			name:  "change either way",
+			rule:  Rule{Kind: KindRateOfChange, Value: 40, Within: Duration(5 * time.Minute)},
+			steps: []step{{0, 100}, {time.Minute, 145}, {2 * time.Minute, 100}},
+			want:  []bool{false, true, true},
+		},
+		{
+			name:  "change outside the window",
+			rule:  Rule{Kind: KindRateOfChange, Operator: ">", Value: 40, Within: Duration(5 * time.Minute)},
+			steps: []step{{0, 70}, {6 * time.Minute, 115}},
+			want:  []bool{false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &ruleState{}
+			s := &series{}
+			for i, st := range tt.steps {
+				sample := Sample{Value: st.value, RecordedAt: t0.Add(st.after)}
+				if got := tt.rule.matches(state, s, sample); got != tt.want[i] {
+					t.Errorf("step %d (%s, %g): matches() = %t, want %t", i, st.after, st.value, got, tt.want[i])
+				}
+				s.points = append(s.points, point{at: sample.RecordedAt, value: sample.Value})
+			}
+		})
+	}
+}
+
+func TestDetectorDedup(t *testing.T) {
+	rules := []Rule{
+		{Name: "low_oxygen", DataType: "oxygen_saturation", Kind: KindThreshold, Operator: "<", Value: 90, Severity: SeverityCritical, Dedup: Duration(10 * time.Minute)},
+	}
+	detector := &Detector{
+		rules:  &RuleSet{rules: byDataType(rules), users: map[string]map[string][]Rule{}},
+		series: make(map[seriesKey]*series),
+		states: make(map[stateKey]*ruleState),
+		now:    func() time.Time { return t0 },
+	}
+
+	const oxygen = "oxygen_saturation"
+	tests := []struct {
+		name     string
+		userID   string
+		dataType string
+		after    time.Duration
+		value    float64
+		want     int
+	}{
+		{name: "first breach", userID: "u1", dataType: oxygen, after: 0, value: 85, want: 1},
+		{name: "within the dedup window", userID: "u1", dataType: oxygen, after: 5 * time.Minute, value: 84, want: 0},
+		{name: "other user", userID: "u2", dataType: oxygen, after: 5 * time.Minute, value: 84, want: 1},
+		{name: "normal sample", userID: "u1", dataType: oxygen, after: 6 * time.Minute, value: 97, want: 0},
+		{name: "breach again within the window", userID: "u1", dataType: oxygen, after: 9 * time.Minute, value: 85, want: 0},
+		{name: "after the dedup window", userID: "u1", dataType: oxygen, after: 10 * time.Minute, value: 85, want: 1},
+		{name: "late sample within the window", userID: "u1", dataType: oxygen, after: 2 * time.Minute, value: 85, want: 0},
+		{name: "data type without rules", userID: "u1", dataType: "heart_rate", after: 30 * time.Minute, value: 50, want: 0},
+	}
+
+	for _, tt := range tests {
+		alerts := detector.Evaluate(Sample{UserID: tt.userID, DataType: tt.dataType, Value: tt.value, RecordedAt: t0.Add(tt.after)})
+		if len(alerts) != tt.want {
+			t.Errorf("%s: Evaluate() raised %d alerts, want %d", tt.name, len(alerts), tt.want)
+			continue
+		}
+		for _, alert := range alerts {
+			if alert.UserId != tt.userID || alert.Rule != "low_oxygen" || alert.Severity != SeverityCritical || alert.Value != tt.value || alert.Threshold != 90 {
+				t.Errorf("%s: alert = %v", tt.name, alert)
+			}
+			if !strings.Contains(alert.Message, "oxygen_saturation < 90") {
+				t.Errorf("%s: alert message = %q", tt.name, alert.Message)
+			}
+		}
+	}
+}
+
+func TestNilDetector(t *testing.T) {
+	var detector *Detector
+	if alerts := detector.Evaluate(Sample{UserID: "u1", DataType: "heart_rate", Value: 200, RecordedAt: t0}); alerts != nil {
+		t.Errorf("Evaluate() = %v, want none", alerts)
+	}
+	if err := detector.Close(); err != nil {
+		t.Errorf("Close() = %v", err)
+	}
+}
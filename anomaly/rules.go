@@ -0,0 +1,208 @@
+package anomaly
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Kinds of rules.
+const (
+	// KindThreshold matches samples beyond a value, optionally for a minimum
+	// duration.
+	KindThreshold = "threshold"
+	// KindRateOfChange matches samples that moved by at least a value from
+	// the other samples of a window.
+	KindRateOfChange = "rate_of_change"
+)
+
+// Severities of the alerts raised by rules.
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Duration is a time.Duration written as a Go duration string in rule files,
+// e.g. "10m".
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"10m\"")
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// Rule is an anomaly rule applying to the samples of a data type.
+//
+// Threshold rules match samples compared by Operator to Value, and only once
+// the samples of the user matched continuously for For. Rate of change rules
+// match samples that rose (">"), fell ("<") or moved either way (no operator)
+// by at least Value from a sample of the last Within.
+type Rule struct {
+	Name     string   `json:"name"`
+	DataType string   `json:"data_type"`
+	Kind     string   `json:"kind"`
+	Operator string   `json:"operator"`
+	Value    float64  `json:"value"`
+	For      Duration `json:"for"`
+	Within   Duration `json:"within"`
+	Severity string   `json:"severity"`
+	// Dedup suppresses further alerts of the rule for the user during this
+	// window after one is raised. Defaults to the dedup window of the file.
+	Dedup    Duration `json:"dedup"`
+	Disabled bool     `json:"disabled"`
+}
+
+// ruleFile is the format of the rules file.
+//
+//	{
+//	  "dedup_window": "30m",
+//	  "rules": [
+//	    {"name": "high_heart_rate", "data_type": "heart_rate", "operator": ">", "value": 120, "for": "10m", "severity": "warning"}
+//	  ],
+//	  "overrides": {
+//	    "<user_id>": [{"name": "high_heart_rate", "value": 140}]
+//	  }
+//	}
+//
+// Overrides replace the fields they set in the rule of the same name.
+type ruleFile struct {
+	DedupWindow Duration                     `json:"dedup_window"`
+	Rules       []Rule                       `json:"rules"`
+	Overrides   map[string][]json.RawMessage `json:"overrides"`
+}
+
+// RuleSet holds the rules by data type, for all users and per user with
+// overrides.
+type RuleSet struct {
+	rules map[string][]Rule
+	users map[string]map[string][]Rule
+}
+
+// LoadRules reads a rules file.
+func LoadRules(path string) (*RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file ruleFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid anomaly rules %s: %w", path, err)
+	}
+
+	byName := make(map[string]Rule, len(file.Rules))
+	var rules []Rule
+	for _, rule := range file.Rules {
+		if rule.Dedup == 0 {
+			rule.Dedup = file.DedupWindow
+		}
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("invalid anomaly rule %q: %w", rule.Name, err)
+		}
+		if _, ok := byName[rule.Name]; ok {
+			return nil, fmt.Errorf("duplicate anomaly rule %q", rule.Name)
+		}
+		byName[rule.Name] = rule
+		rules = append(rules, rule)
+	}
+
+	set := &RuleSet{rules: byDataType(rules), users: make(map[string]map[string][]Rule)}
+	for userID, overrides := range file.Overrides {
+		userRules := rules
+		for _, raw := range overrides {
+			var ref struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(raw, &ref); err != nil {
+				return nil, fmt.Errorf("invalid anomaly override for %s: %w", userID, err)
+			}
+			rule, ok := byName[ref.Name]
+			if !ok {
+				return nil, fmt.Errorf("anomaly override for %s names unknown rule %q", userID, ref.Name)
+			}
+			if err := json.Unmarshal(raw, &rule); err != nil {
+				return nil, fmt.Errorf("invalid anomaly override of %q for %s: %w", ref.Name, userID, err)
+			}
+			if err := rule.validate(); err != nil {
+				return nil, fmt.Errorf("invalid anomaly override of %q for %s: %w", ref.Name, userID, err)
+			}
+			userRules = replace(userRules, rule)
+		}
+		set.users[userID] = byDataType(userRules)
+	}
+
+	return set, nil
+}
+
+// For returns the enabled rules applying to a sample of the user.
+func (s *RuleSet) For(userID, dataType string) []Rule {
+	if rules, ok := s.users[userID]; ok {
+		return rules[dataType]
+	}
+	return s.rules[dataType]
+}
+
+func (r *Rule) validate() error {
+	if r.Name == "" || r.DataType == "" {
+		return fmt.Errorf("name and data_type are required")
+	}
+	switch r.Severity {
+	case SeverityInfo, SeverityWarning, SeverityCritical:
+	default:
+		return fmt.Errorf("severity must be info, warning or critical")
+	}
+
+	switch r.Kind {
+	case "", KindThreshold:
+		r.Kind = KindThreshold
+		switch r.Operator {
+		case ">", ">=", "<", "<=":
+		default:
+			return fmt.Errorf("threshold operator must be >, >=, < or <=")
+		}
+	case KindRateOfChange:
+		if r.Operator != "" && r.Operator != ">" && r.Operator != "<" {
+			return fmt.Errorf("rate of change operator must be >, < or empty")
+		}
+		if r.Within <= 0 || r.Value <= 0 {
+			return fmt.Errorf("rate of change rules need a positive value and within")
+		}
+	default:
+		return fmt.Errorf("kind must be threshold or rate_of_change")
+	}
+	return nil
+}
+
+// byDataType indexes the enabled rules by data type.
+func byDataType(rules []Rule) map[string][]Rule {
+	index := make(map[string][]Rule)
+	for _, rule := range rules {
+		if !rule.Disabled {
+			index[rule.DataType] = append(index[rule.DataType], rule)
+		}
+	}
+	return index
+}
+
+// replace returns a copy of rules with the rule of the same name replaced.
+func replace(rules []Rule, rule Rule) []Rule {
+	replaced := make([]Rule, len(rules))
+	for i, r := range rules {
+		if r.Name == rule.Name {
+			r = rule
+		}
+		replaced[i] = r
+	}
+	return replaced
+}
@@ -0,0 +1,136 @@
+package anomaly
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeRules writes a rules file and returns its path.
+func writeRules(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "rules.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadRulesOverrides(t *testing.T) {
+	path := writeRules(t, `{
+		"dedup_window": "30m",
+		"rules": [
+			{"name": "high", "data_type": "heart_rate", "operator": ">", "value": 120, "for": "10m", "severity": "warning"},
+			{"name": "low", "data_type": "heart_rate", "operator": "<", "value": 40, "severity": "critical", "dedup": "5m"},
+			{"name": "off", "data_type": "heart_rate", "operator": ">", "value": 200, "severity": "info", "disabled": true}
+		],
+		"overrides": {
+			"athlete": [{"name": "low", "value": 35}, {"name": "high", "disabled": true}],
+			"patient": [{"name": "off", "disabled": false}]
+		}
+	}`)
+	rules, err := LoadRules(path)
+	if err != nil {
+		t.Fatalf("LoadRules: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		want   []Rule
+	}{
+		{
+			name:   "defaults",
+			userID: "u1",
+			want: []Rule{
+				{Name: "high", DataType: "heart_rate", Kind: KindThreshold, Operator: ">", Value: 120, For: Duration(10 * time.Minute), Severity: SeverityWarning, Dedup: Duration(30 * time.Minute)},
+				{Name: "low", DataType: "heart_rate", Kind: KindThreshold, Operator: "<", Value: 40, Severity: SeverityCritical, Dedup: Duration(5 * time.Minute)},
+			},
+		},
+		{
+			name:   "override merges into the rule and disables another",
+			userID: "athlete",
+			want: []Rule{
+				{Name: "low", DataType: "heart_rate", Kind: KindThreshold, Operator: "<", Value: 35, Severity: SeverityCritical, Dedup: Duration(5 * time.Minute)},
+			},
+		},
+		{
+			name:   "override enables a disabled rule",
+			userID: "patient",
+			want: []Rule{
+				{Name: "high", DataType: "heart_rate", Kind: KindThreshold, Operator: ">", Value: 120, For: Duration(10 * time.Minute), Severity: SeverityWarning, Dedup: Duration(30 * time.Minute)},
+				{Name: "low", DataType: "heart_rate", Kind: KindThreshold, Operator: "<", Value: 40, Severity: SeverityCritical, Dedup: Duration(5 * time.Minute)},
+				{Name: "off", DataType: "heart_rate", Kind: KindThreshold, Operator: ">", Value: 200, Severity: SeverityInfo, Dedup: Duration(30 * time.Minute)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rules.For(tt.userID, "heart_rate")
+			if len(got) != len(tt.want) {
+				t.Fatalf("For(%q) = %+v, want %+v", tt.userID, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("For(%q)[%d] = %+v, want %+v", tt.userID, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+
+	if got := rules.For("u1", "steps"); len(got) != 0 {
+		t.Errorf("For(steps) = %+v, want none", got)
+	}
+}
+
+func TestLoadRulesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "invalid json", content: `{`, wantErr: "invalid anomaly rules"},
+		{name: "invalid duration", content: `{"rules": [{"name": "r", "data_type": "d", "operator": ">", "for": "soon", "severity": "info"}]}`, wantErr: "invalid anomaly rules"},
+		{name: "numeric duration", content: `{"rules": [{"name": "r", "data_type": "d", "operator": ">", "for": 10, "severity": "info"}]}`, wantErr: "duration must be a string"},
+		{name: "missing name", content: `{"rules": [{"data_type": "d", "operator": ">", "severity": "info"}]}`, wantErr: "name and data_type are required"},
+		{name: "unknown severity", content: `{"rules": [{"name": "r", "data_type": "d", "operator": ">", "severity": "fatal"}]}`, wantErr: "severity must be"},
+		{name: "threshold operator", content: `{"rules": [{"name": "r", "data_type": "d", "operator": "==", "severity": "info"}]}`, wantErr: "threshold operator must be"},
+		{name: "rate of change operator", content: `{"rules": [{"name": "r", "data_type": "d", "kind": "rate_of_change", "operator": ">=", "value": 1, "within": "1m", "severity": "info"}]}`, wantErr: "rate of change operator must be"},
+		{name: "rate of change window", content: `{"rules": [{"name": "r", "data_type": "d", "kind": "rate_of_change", "value": 1, "severity": "info"}]}`, wantErr: "positive value and within"},
+		{name: "unknown kind", content: `{"rules": [{"name": "r", "data_type": "d", "kind": "trend", "severity": "info"}]}`, wantErr: "kind must be"},
+		{
+			name:    "duplicate",
+			content: `{"rules": [{"name": "r", "data_type": "d", "operator": ">", "severity": "info"}, {"name": "r", "data_type": "d", "operator": "<", "severity": "info"}]}`,
+			wantErr: `duplicate anomaly rule "r"`,
+		},
+		{
+			name:    "override of an unknown rule",
+			content: `{"rules": [{"name": "r", "data_type": "d", "operator": ">", "severity": "info"}], "overrides": {"u1": [{"name": "x"}]}}`,
+			wantErr: `names unknown rule "x"`,
+		},
+		{
+			name:    "invalid override",
+			content: `{"rules": [{"name": "r", "data_type": "d", "operator": ">", "severity": "info"}], "overrides": {"u1": [{"name": "r", "operator": "!"}]}}`,
+			wantErr: `invalid anomaly override of "r" for u1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRules(writeRules(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadRules() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadShippedRules(t *testing.T) {
+	if _, err := LoadRules("../config/anomaly/rules.json"); err != nil {
+		t.Errorf("LoadRules(config/anomaly/rules.json) = %v", err)
+	}
+}
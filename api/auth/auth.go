@@ -70,3 +70,8 @@ func (c *UserClaims) GetUserRole() string {
 func (c *UserClaims) GetIat() int64 {
 	return c.Iat
 }
+
+// GetTokenID returns the token ID (jti) from the token claims.
+func (c *UserClaims) GetTokenID() string {
+	return c.Id
+}
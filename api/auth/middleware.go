@@ -1,11 +1,15 @@
 package auth
 
 import (
+	"log/slog"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
 	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/config/logger"
+	"github.com/health-analytics-service/api-gateway-health-analytics/identity"
 )
 
 // AuthMiddleware is a Gin middleware function that checks for a valid JWT token.
@@ -16,7 +20,7 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 
 		// Check if the header is present and in the correct format
 		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
+			c.AbortWithStatusJSON(http.StatusUnauthorized, response.ErrorBody(c, "Authorization header required"))
 			return
 		}
 
@@ -29,7 +33,7 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 		// Verify the token
 		claims, err := jwtManager.Verify(tokenString)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			c.AbortWithStatusJSON(http.StatusUnauthorized, response.ErrorBody(c, "Invalid token"))
 			return
 		}
 
@@ -37,6 +41,15 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 		c.Set("userID", claims.GetUserID())
 		c.Set("userRole", claims.GetUserRole())
 
+		// Attach the caller to every log entry and downstream call of this request
+		ctx := logger.AppendCtx(c.Request.Context(), slog.String("user_id", claims.GetUserID()))
+		ctx = identity.NewContext(ctx, identity.Caller{
+			UserID:  claims.GetUserID(),
+			Role:    claims.GetUserRole(),
+			TokenID: claims.GetTokenID(),
+		})
+		c.Request = c.Request.WithContext(ctx)
+
 		// Proceed to the next handler
 		c.Next()
 	}
@@ -48,12 +61,12 @@ func AuthorizationMiddleware() gin.HandlerFunc {
 		// Get user ID and role from the context
 		userID, ok := c.Get("userID")
 		if !ok {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+			c.AbortWithStatusJSON(http.StatusInternalServerError, response.ErrorBody(c, "User ID not found in context"))
 			return
 		}
 		userRole, ok := c.Get("userRole")
 		if !ok {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "User role not found in context"})
+			c.AbortWithStatusJSON(http.StatusInternalServerError, response.ErrorBody(c, "User role not found in context"))
 			return
 		}
 
@@ -68,6 +81,21 @@ func AuthorizationMiddleware() gin.HandlerFunc {
 		}
 
 		// User is not authorized
-		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Unauthorized access"})
+		c.AbortWithStatusJSON(http.StatusForbidden, response.ErrorBody(c, "Unauthorized access"))
+	}
+}
+
+// RequireRoles allows the request only when the authenticated user has one of the given roles.
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := c.GetString("userRole")
+		for _, role := range roles {
+			if userRole == role {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, response.ErrorBody(c, "Unauthorized access"))
 	}
 }
@@ -16,6 +16,207 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/healthz": {
+            "get": {
+                "description": "Reports whether the gateway process is alive.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Probes"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Reports whether the gateway can serve traffic, with a breakdown per dependency.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Probes"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/audit-events": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Search PHI access audit events, newest first. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Search audit events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by actor ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by patient user ID",
+                        "name": "patient_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by entity",
+                        "name": "entity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by record ID",
+                        "name": "record_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by action (read, list, create, update, delete)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by outcome (success, denied, failure)",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Events at or after this time (RFC3339 format)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Events at or before this time (RFC3339 format)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of events, at most 1000",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/audit-events/verify": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Walk the hash chain of the audit log and report the first broken link. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Verify audit log integrity",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/v1/genetic-data": {
             "get": {
                 "security": [
@@ -52,6 +253,36 @@ const docTemplate = `{
                         "description": "Filter by analysis date (YYYY-MM-DD)",
                         "name": "analysis_date",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest analysis date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest analysis date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -61,6 +292,13 @@ const docTemplate = `{
                             "$ref": "#/definitions/health.ListGeneticDataResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -148,6 +386,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy, answered with 304 while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -155,6 +399,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/health.GeneticData"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the record"
+                            }
                         }
                     },
                     "400": {
@@ -213,6 +463,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/health.GeneticData"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -230,6 +486,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -263,6 +533,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -280,6 +556,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -288,16 +578,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/health-monitoring/daily-summary/{user_id}": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a daily summary of health data for a user.",
+                "description": "Partially update a genetic data record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.",
                 "consumes": [
                     "application/json"
                 ],
@@ -305,30 +593,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HealthMonitoring"
+                    "GeneticData"
                 ],
-                "summary": "Get Daily Summary",
+                "summary": "Patch Genetic Data",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Genetic Data ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
+                        "description": "Comma separated fields to update, defaults to the fields in the body",
+                        "name": "update_mask",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "geneticData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.GeneticData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/health.SummaryResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -338,66 +641,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
-                    }
-                }
-            }
-        },
-        "/v1/health-monitoring/weekly-summary/{user_id}": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get a weekly summary of health data for a user.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "HealthMonitoring"
-                ],
-                "summary": "Get Weekly Summary",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Start Date (YYYY-MM-DD)",
-                        "name": "start_date",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End Date (YYYY-MM-DD)",
-                        "name": "end_date",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/health.SummaryResponse"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -413,14 +665,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/health-recommendations": {
+        "/v1/health-goals": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of health recommendation records.",
+                "description": "Get a list of health goals.",
                 "consumes": [
                     "application/json"
                 ],
@@ -428,9 +680,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HealthRecommendations"
+                    "HealthGoals"
                 ],
-                "summary": "List Health Recommendations",
+                "summary": "List Health Goals",
                 "parameters": [
                     {
                         "type": "string",
@@ -440,14 +692,38 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by recommendation type",
-                        "name": "recommendation_type",
+                        "description": "Filter by source (wearable or lifestyle)",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by data type",
+                        "name": "data_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by period (daily or weekly)",
+                        "name": "period",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Filter by priority",
-                        "name": "priority",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
                         "in": "query"
                     }
                 ],
@@ -455,7 +731,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/health.ListHealthRecommendationsResponse"
+                            "$ref": "#/definitions/health.ListHealthGoalsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "500": {
@@ -473,7 +756,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new health recommendation record with the provided details.",
+                "description": "Create a new health goal, e.g. at least 10000 wearable steps a day or 28800000 ms of lifestyle sleep a day.",
                 "consumes": [
                     "application/json"
                 ],
@@ -481,17 +764,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HealthRecommendations"
+                    "HealthGoals"
                 ],
-                "summary": "Create a new Health Recommendation record",
+                "summary": "Create a new Health Goal",
                 "parameters": [
                     {
-                        "description": "Health Recommendation details",
-                        "name": "healthRecommendation",
+                        "description": "Health Goal details",
+                        "name": "healthGoal",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/health.HealthRecommendation"
+                            "$ref": "#/definitions/health.HealthGoal"
                         }
                     }
                 ],
@@ -520,14 +803,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/health-recommendations/{id}": {
+        "/v1/health-goals/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get details of a health recommendation record by its ID.",
+                "description": "Get details of a health goal by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -535,23 +818,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HealthRecommendations"
+                    "HealthGoals"
                 ],
-                "summary": "Get Health Recommendation by ID",
+                "summary": "Get Health Goal by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Health Recommendation ID",
+                        "description": "Health Goal ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy, answered with 304 while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/health.HealthRecommendation"
+                            "$ref": "#/definitions/health.HealthGoal"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the record"
+                            }
                         }
                     },
                     "400": {
@@ -583,7 +878,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update an existing health recommendation record.",
+                "description": "Update an existing health goal.",
                 "consumes": [
                     "application/json"
                 ],
@@ -591,25 +886,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HealthRecommendations"
+                    "HealthGoals"
                 ],
-                "summary": "Update Health Recommendation",
+                "summary": "Update Health Goal",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Health Recommendation ID",
+                        "description": "Health Goal ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated health recommendation",
-                        "name": "healthRecommendation",
+                        "description": "Updated health goal",
+                        "name": "healthGoal",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/health.HealthRecommendation"
+                            "$ref": "#/definitions/health.HealthGoal"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -627,6 +928,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -642,7 +957,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a health recommendation record by its ID.",
+                "description": "Delete a health goal by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -650,16 +965,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HealthRecommendations"
+                    "HealthGoals"
                 ],
-                "summary": "Delete Health Recommendation",
+                "summary": "Delete Health Goal",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Health Recommendation ID",
+                        "description": "Health Goal ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -677,6 +998,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -685,16 +1020,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/lifestyle-data": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of lifestyle data records.",
+                "description": "Partially update a health goal. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.",
                 "consumes": [
                     "application/json"
                 ],
@@ -702,34 +1035,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LifestyleData"
+                    "HealthGoals"
                 ],
-                "summary": "List Lifestyle Data",
+                "summary": "Patch Health Goal",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by user ID",
-                        "name": "user_id",
-                        "in": "query"
+                        "description": "Health Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter by data type",
-                        "name": "data_type",
+                        "description": "Comma separated fields to update, defaults to the fields in the body",
+                        "name": "update_mask",
                         "in": "query"
                     },
+                    {
+                        "description": "Fields to update",
+                        "name": "healthGoal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthGoal"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Filter by recorded date (YYYY-MM-DD)",
-                        "name": "recorded_date",
-                        "in": "query"
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/health.ListLifestyleDataResponse"
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "500": {
@@ -740,14 +1105,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/health-goals/{id}/evaluate": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new lifestyle data record with the provided details.",
+                "description": "Publish a health_goal.achieved or health_goal.missed event for each period of a health goal that ended on or after since, by default the latest one to end. Events of a period keep the same ID across evaluations so consumers can drop repeats; run it after each period ends, e.g. from a daily job. Admins and doctors only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -755,18 +1122,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LifestyleData"
+                    "HealthGoals"
                 ],
-                "summary": "Create a new Lifestyle Data record",
+                "summary": "Evaluate Health Goal",
                 "parameters": [
                     {
-                        "description": "Lifestyle Data details",
-                        "name": "lifestyleData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/health.LifestyleData"
-                        }
+                        "type": "string",
+                        "description": "Health Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest period end date (YYYY-MM-DD)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "UTC",
+                        "description": "IANA time zone days are taken in",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -784,6 +1162,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -794,14 +1186,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/lifestyle-data/{id}": {
+        "/v1/health-goals/{id}/progress": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get details of a lifestyle data record by its ID.",
+                "description": "Get the progress of a health goal over each of its periods up to today, with its streaks. Periods older than GOAL_PROGRESS_MAX_DAYS days are left out, and so from the streaks.",
                 "consumes": [
                     "application/json"
                 ],
@@ -809,23 +1201,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LifestyleData"
+                    "HealthGoals"
                 ],
-                "summary": "Get Lifestyle Data by ID",
+                "summary": "Get Health Goal progress",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lifestyle Data ID",
+                        "description": "Health Goal ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "UTC",
+                        "description": "IANA time zone days are taken in",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/health.LifestyleData"
+                            "$ref": "#/definitions/health.HealthGoalProgress"
                         }
                     },
                     "400": {
@@ -850,14 +1249,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/health-monitoring/daily-summary/{user_id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update an existing lifestyle data record.",
+                "description": "Get a daily summary of health data for a user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -865,33 +1266,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LifestyleData"
+                    "HealthMonitoring"
                 ],
-                "summary": "Update Lifestyle Data",
+                "summary": "Get Daily Summary",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lifestyle Data ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated lifestyle data",
-                        "name": "lifestyleData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/health.LifestyleData"
-                        }
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/health.SummaryResponse"
                         }
                     },
                     "400": {
@@ -909,14 +1307,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/health-monitoring/monthly-summary/{user_id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a lifestyle data record by its ID.",
+                "description": "Get the per-entity counts and statistics of a calendar month of health data for a user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -924,24 +1324,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LifestyleData"
+                    "HealthMonitoring"
                 ],
-                "summary": "Delete Lifestyle Data",
+                "summary": "Get Monthly Summary",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lifestyle Data ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM)",
+                        "name": "month",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include the summarized records",
+                        "name": "include_records",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/health.SummaryReport"
                         }
                     },
                     "400": {
@@ -961,14 +1373,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/medical-records": {
+        "/v1/health-monitoring/summary/{user_id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of medical records.",
+                "description": "Get the per-entity counts and statistics of an arbitrary range of health data for a user. The range spans at most SUMMARY_MAX_RANGE_DAYS days, 92 by default.",
                 "consumes": [
                     "application/json"
                 ],
@@ -976,38 +1388,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MedicalRecords"
+                    "HealthMonitoring"
                 ],
-                "summary": "List Medical Records",
+                "summary": "Get Range Summary",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by user ID",
+                        "description": "User ID",
                         "name": "user_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by record type",
-                        "name": "record_type",
-                        "in": "query"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter by record date (YYYY-MM-DD)",
-                        "name": "record_date",
-                        "in": "query"
+                        "description": "Start Date (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter by description",
-                        "name": "description",
-                        "in": "query"
+                        "description": "End Date (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by doctor ID",
-                        "name": "doctor_id",
+                        "type": "boolean",
+                        "description": "Include the summarized records",
+                        "name": "include_records",
                         "in": "query"
                     }
                 ],
@@ -1015,7 +1424,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/health.ListMedicalRecordsResponse"
+                            "$ref": "#/definitions/health.SummaryReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "500": {
@@ -1026,14 +1442,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/health-monitoring/weekly-summary/{user_id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new medical record with the provided details.",
+                "description": "Get a weekly summary of health data for a user. The range spans at most 7 days.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1041,26 +1459,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MedicalRecords"
+                    "HealthMonitoring"
                 ],
-                "summary": "Create a new Medical Record",
+                "summary": "Get Weekly Summary",
                 "parameters": [
                     {
-                        "description": "Medical Record details",
-                        "name": "medicalRecord",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/health.MedicalRecord"
-                        }
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Date (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Date (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/health.SummaryResponse"
                         }
                     },
                     "400": {
@@ -1080,14 +1509,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/medical-records/{id}": {
+        "/v1/health-recommendations": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get details of a medical record by its ID.",
+                "description": "Get a list of health recommendation records.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1095,23 +1524,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MedicalRecords"
+                    "HealthRecommendations"
                 ],
-                "summary": "Get Medical Record by ID",
+                "summary": "List Health Recommendations",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Medical Record ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by user ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by recommendation type",
+                        "name": "recommendation_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by priority",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/health.MedicalRecord"
+                            "$ref": "#/definitions/health.ListHealthRecommendationsResponse"
                         }
                     },
                     "400": {
@@ -1121,13 +1579,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1137,13 +1588,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update an existing medical record.",
+                "description": "Create a new health recommendation record with the provided details.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1151,24 +1602,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MedicalRecords"
+                    "HealthRecommendations"
                 ],
-                "summary": "Update Medical Record",
+                "summary": "Create a new Health Recommendation record",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Medical Record ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated medical record",
-                        "name": "medicalRecord",
+                        "description": "Health Recommendation details",
+                        "name": "healthRecommendation",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/health.MedicalRecord"
+                            "$ref": "#/definitions/health.HealthRecommendation"
                         }
                     }
                 ],
@@ -1195,14 +1639,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/health-recommendations/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a medical record by its ID.",
+                "description": "Get details of a health recommendation record by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1210,24 +1656,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MedicalRecords"
+                    "HealthRecommendations"
                 ],
-                "summary": "Delete Medical Record",
+                "summary": "Get Health Recommendation by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Medical Record ID",
+                        "description": "Health Recommendation ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy, answered with 304 while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/health.HealthRecommendation"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the record"
+                            }
                         }
                     },
                     "400": {
@@ -1237,6 +1694,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1245,16 +1709,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/wearable-data": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of wearable data records.",
+                "description": "Update an existing health recommendation record.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1262,32 +1724,1848 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WearableData"
+                    "HealthRecommendations"
                 ],
-                "summary": "List Wearable Data",
+                "summary": "Update Health Recommendation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by user ID",
-                        "name": "user_id",
-                        "in": "query"
+                        "description": "Health Recommendation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated health recommendation",
+                        "name": "healthRecommendation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthRecommendation"
+                        }
                     },
                     {
                         "type": "string",
-                        "description": "Filter by device type",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a health recommendation record by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HealthRecommendations"
+                ],
+                "summary": "Delete Health Recommendation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Health Recommendation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Partially update a health recommendation. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HealthRecommendations"
+                ],
+                "summary": "Patch Health Recommendation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Health Recommendation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to update, defaults to the fields in the body",
+                        "name": "update_mask",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "healthRecommendation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthRecommendation"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/heart-rate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the heart rate series of a user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HeartRate"
+                ],
+                "summary": "List Heart Rate Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by device type",
+                        "name": "device_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.HeartRateSeries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record a heart rate reading. It is stored as wearable data of type \"heart_rate\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HeartRate"
+                ],
+                "summary": "Record Heart Rate Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Device that took the reading, defaults to heart_rate_monitor",
+                        "name": "device_type",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Heart Rate Data details",
+                        "name": "heartRateData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.HeartRateData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lifestyle-data": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of lifestyle data records.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LifestyleData"
+                ],
+                "summary": "List Lifestyle Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by user ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by data type",
+                        "name": "data_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by recorded date (YYYY-MM-DD)",
+                        "name": "recorded_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest recorded date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest recorded date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.ListLifestyleDataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new lifestyle data record with the provided details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LifestyleData"
+                ],
+                "summary": "Create a new Lifestyle Data record",
+                "parameters": [
+                    {
+                        "description": "Lifestyle Data details",
+                        "name": "lifestyleData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.LifestyleData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lifestyle-data/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get details of a lifestyle data record by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LifestyleData"
+                ],
+                "summary": "Get Lifestyle Data by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lifestyle Data ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy, answered with 304 while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.LifestyleData"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the record"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update an existing lifestyle data record.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LifestyleData"
+                ],
+                "summary": "Update Lifestyle Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lifestyle Data ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated lifestyle data",
+                        "name": "lifestyleData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.LifestyleData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a lifestyle data record by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LifestyleData"
+                ],
+                "summary": "Delete Lifestyle Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lifestyle Data ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Partially update a lifestyle data record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LifestyleData"
+                ],
+                "summary": "Patch Lifestyle Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lifestyle Data ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to update, defaults to the fields in the body",
+                        "name": "update_mask",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "lifestyleData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.LifestyleData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/medical-records": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of medical records.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MedicalRecords"
+                ],
+                "summary": "List Medical Records",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by user ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by record type",
+                        "name": "record_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by record date (YYYY-MM-DD)",
+                        "name": "record_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by description",
+                        "name": "description",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by doctor ID",
+                        "name": "doctor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest record date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest record date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.ListMedicalRecordsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new medical record with the provided details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MedicalRecords"
+                ],
+                "summary": "Create a new Medical Record",
+                "parameters": [
+                    {
+                        "description": "Medical Record details",
+                        "name": "medicalRecord",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.MedicalRecord"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/medical-records/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get details of a medical record by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MedicalRecords"
+                ],
+                "summary": "Get Medical Record by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy, answered with 304 while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.MedicalRecord"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the record"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update an existing medical record.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MedicalRecords"
+                ],
+                "summary": "Update Medical Record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated medical record",
+                        "name": "medicalRecord",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.MedicalRecord"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a medical record by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MedicalRecords"
+                ],
+                "summary": "Delete Medical Record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Partially update a medical record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MedicalRecords"
+                ],
+                "summary": "Patch Medical Record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to update, defaults to the fields in the body",
+                        "name": "update_mask",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "medicalRecord",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.MedicalRecord"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recommendation-rules": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the version of the recommendation rules currently loaded and their rules.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RecommendationRules"
+                ],
+                "summary": "List recommendation rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RecommendationRulesResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recommendation-rules/evaluate/{user_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Evaluate the recommendation rules of a period against the daily or weekly summary of a user, without publishing any recommendation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RecommendationRules"
+                ],
+                "summary": "Dry-run recommendation rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Summary period (daily or weekly)",
+                        "name": "period",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date of a daily summary (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Date of a weekly summary (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Date of a weekly summary (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RecommendationEvaluationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Evaluate the recommendation rules of a period against the daily or weekly summary of a user and publish the recommendation of every rule that fires. Admins and doctors only. A recommendation keeps the same ID across runs of the same rules version, so a request that failed after publishing some of them, reported with a 500 carrying the evaluation and the number published, can be retried.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RecommendationRules"
+                ],
+                "summary": "Generate recommendations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Summary period (daily or weekly)",
+                        "name": "period",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date of a daily summary (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Date of a weekly summary (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Date of a weekly summary (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RecommendationEvaluationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RecommendationEvaluationResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sleep": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the sleep series of a user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sleep"
+                ],
+                "summary": "List Sleep Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest recorded date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest recorded date, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.SleepSeries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record a night of sleep. It is stored as lifestyle data of type \"sleep\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sleep"
+                ],
+                "summary": "Record Sleep Data",
+                "parameters": [
+                    {
+                        "description": "Sleep Data details",
+                        "name": "sleepData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.SleepData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/wearable-data": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of wearable data records.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WearableData"
+                ],
+                "summary": "List Wearable Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by user ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by device type",
+                        "name": "device_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by data type",
+                        "name": "data_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by recorded timestamp (RFC3339 format)",
+                        "name": "recorded_timestamp",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, capped at the configured maximum",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, \\",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.ListWearableDataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new wearable data record with the provided details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WearableData"
+                ],
+                "summary": "Create a new Wearable Data record",
+                "parameters": [
+                    {
+                        "description": "Wearable Data details",
+                        "name": "wearableData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.WearableData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/wearable-data/aggregate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Roll up the numeric wearable data of a user into minute, hour or day buckets aligned to a time zone. Buckets without samples are omitted. With \"Accept: application/x-ndjson\" buckets are streamed one per line as they are computed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "WearableData"
+                ],
+                "summary": "Aggregate Wearable Data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data type, e.g. heart_rate, steps or oxygen_saturation",
+                        "name": "data_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by device type",
                         "name": "device_type",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by data type",
-                        "name": "data_type",
+                        "description": "Earliest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD in tz)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD in tz)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "hour",
+                        "description": "Bucket size: minute, hour or day",
+                        "name": "bucket",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "count,min,max,avg",
+                        "description": "Comma separated functions: count, min, max, avg, pNN (e.g. p95)",
+                        "name": "functions",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by recorded timestamp (RFC3339 format)",
-                        "name": "recorded_timestamp",
+                        "default": "UTC",
+                        "description": "IANA time zone buckets are aligned to",
+                        "name": "tz",
                         "in": "query"
                     }
                 ],
@@ -1295,7 +3573,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/health.ListWearableDataResponse"
+                            "$ref": "#/definitions/health.WearableDataAggregate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "500": {
@@ -1306,14 +3591,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/wearable-data/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new wearable data record with the provided details.",
+                "description": "Get details of a wearable data record by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1323,24 +3610,33 @@ const docTemplate = `{
                 "tags": [
                     "WearableData"
                 ],
-                "summary": "Create a new Wearable Data record",
+                "summary": "Get Wearable Data by ID",
                 "parameters": [
                     {
-                        "description": "Wearable Data details",
-                        "name": "wearableData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/health.WearableData"
-                        }
+                        "type": "string",
+                        "description": "Wearable Data ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy, answered with 304 while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/health.WearableData"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the record"
+                            }
                         }
                     },
                     "400": {
@@ -1350,6 +3646,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1358,16 +3661,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/wearable-data/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get details of a wearable data record by its ID.",
+                "description": "Update an existing wearable data record.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1377,7 +3678,7 @@ const docTemplate = `{
                 "tags": [
                     "WearableData"
                 ],
-                "summary": "Get Wearable Data by ID",
+                "summary": "Update Wearable Data",
                 "parameters": [
                     {
                         "type": "string",
@@ -1385,13 +3686,29 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Updated wearable data",
+                        "name": "wearableData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.WearableData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/health.WearableData"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -1408,6 +3725,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1417,13 +3741,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update an existing wearable data record.",
+                "description": "Delete a wearable data record by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1433,7 +3757,7 @@ const docTemplate = `{
                 "tags": [
                     "WearableData"
                 ],
-                "summary": "Update Wearable Data",
+                "summary": "Delete Wearable Data",
                 "parameters": [
                     {
                         "type": "string",
@@ -1443,18 +3767,15 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Updated wearable data",
-                        "name": "wearableData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/health.WearableData"
-                        }
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1467,6 +3788,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1476,13 +3811,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a wearable data record by its ID.",
+                "description": "Partially update a wearable data record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1492,7 +3827,7 @@ const docTemplate = `{
                 "tags": [
                     "WearableData"
                 ],
-                "summary": "Delete Wearable Data",
+                "summary": "Patch Wearable Data",
                 "parameters": [
                     {
                         "type": "string",
@@ -1500,11 +3835,32 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to update, defaults to the fields in the body",
+                        "name": "update_mask",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "wearableData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/health.WearableData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1517,6 +3873,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1526,27 +3896,178 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "health.GeneticData": {
+        }
+    },
+    "definitions": {
+        "handlers.DependencyStatus": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/handlers.DependencyStatus"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.RecommendationEvaluationResponse": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Error reports why publishing stopped, after Published recommendations.",
+                    "type": "string"
+                },
+                "evaluations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recommend.Evaluation"
+                    }
+                },
+                "period": {
+                    "type": "string"
+                },
+                "published": {
+                    "description": "Published is the number of recommendations published, only set when\nthe recommendations are generated.",
+                    "type": "integer"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "rules_version": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.RecommendationRulesResponse": {
+            "type": "object",
+            "properties": {
+                "loaded_at": {
+                    "type": "string"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recommend.Rule"
+                    }
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.EntityCounts": {
+            "type": "object",
+            "properties": {
+                "genetic_data": {
+                    "type": "integer"
+                },
+                "health_recommendations": {
+                    "type": "integer"
+                },
+                "lifestyle_data": {
+                    "type": "integer"
+                },
+                "medical_records": {
+                    "type": "integer"
+                },
+                "wearable_data": {
+                    "type": "integer"
+                }
+            }
+        },
+        "health.GeneticData": {
+            "type": "object",
+            "properties": {
+                "analysis_date": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "data_type": {
+                    "type": "string"
+                },
+                "data_value": {
+                    "type": "object"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.HealthGoal": {
             "type": "object",
             "properties": {
-                "analysis_date": {
-                    "type": "string"
-                },
                 "created_at": {
                     "type": "string"
                 },
                 "data_type": {
+                    "description": "Data type of the source, e.g. steps, sleep or exercise",
                     "type": "string"
                 },
-                "data_value": {
+                "description": {
+                    "type": "string"
+                },
+                "direction": {
+                    "description": "at_least (default) or at_most the target",
+                    "type": "string"
+                },
+                "end_date": {
+                    "description": "Last day of the goal (YYYY-MM-DD), empty while open-ended",
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "period": {
+                    "description": "daily or weekly",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "wearable or lifestyle",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "First day of the goal (YYYY-MM-DD), weekly periods start on its weekday",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "In the unit of the data, e.g. steps or milliseconds of sleep",
+                    "type": "number"
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -1555,6 +4076,55 @@ const docTemplate = `{
                 }
             }
         },
+        "health.HealthGoalPeriod": {
+            "type": "object",
+            "properties": {
+                "achieved": {
+                    "type": "boolean"
+                },
+                "complete": {
+                    "description": "Whether the period has ended",
+                    "type": "boolean"
+                },
+                "end_date": {
+                    "description": "YYYY-MM-DD, inclusive",
+                    "type": "string"
+                },
+                "percent": {
+                    "description": "Value as a percentage of the target",
+                    "type": "number"
+                },
+                "start_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "health.HealthGoalProgress": {
+            "type": "object",
+            "properties": {
+                "current_streak": {
+                    "description": "Achieved periods in a row up to the latest one, the current period counting once achieved",
+                    "type": "integer"
+                },
+                "goal": {
+                    "$ref": "#/definitions/health.HealthGoal"
+                },
+                "longest_streak": {
+                    "type": "integer"
+                },
+                "periods": {
+                    "description": "Oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.HealthGoalPeriod"
+                    }
+                }
+            }
+        },
         "health.HealthRecommendation": {
             "type": "object",
             "properties": {
@@ -1581,6 +4151,37 @@ const docTemplate = `{
                 }
             }
         },
+        "health.HeartRateData": {
+            "type": "object",
+            "properties": {
+                "heart_rate": {
+                    "description": "Heart rate in beats per minute (BPM)",
+                    "type": "integer"
+                },
+                "recorded_timestamp": {
+                    "description": "Timestamp when the heart rate was recorded (RFC3339 format)",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.HeartRateSeries": {
+            "type": "object",
+            "properties": {
+                "heart_rate_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.HeartRateData"
+                    }
+                },
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
+                }
+            }
+        },
         "health.LifestyleData": {
             "type": "object",
             "properties": {
@@ -1591,7 +4192,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "data_value": {
-                    "type": "string"
+                    "type": "object"
                 },
                 "id": {
                     "type": "string"
@@ -1615,6 +4216,25 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/health.GeneticData"
                     }
+                },
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
+                }
+            }
+        },
+        "health.ListHealthGoalsResponse": {
+            "type": "object",
+            "properties": {
+                "health_goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.HealthGoal"
+                    }
+                },
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
                 }
             }
         },
@@ -1626,6 +4246,10 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/health.HealthRecommendation"
                     }
+                },
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
                 }
             }
         },
@@ -1637,6 +4261,10 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/health.LifestyleData"
                     }
+                },
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
                 }
             }
         },
@@ -1648,12 +4276,20 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/health.MedicalRecord"
                     }
+                },
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
                 }
             }
         },
         "health.ListWearableDataResponse": {
             "type": "object",
             "properties": {
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
+                },
                 "wearable_data": {
                     "type": "array",
                     "items": {
@@ -1697,6 +4333,92 @@ const docTemplate = `{
                 }
             }
         },
+        "health.MetricStatistics": {
+            "type": "object",
+            "properties": {
+                "avg": {
+                    "type": "number"
+                },
+                "count": {
+                    "description": "Records with a numeric value",
+                    "type": "integer"
+                },
+                "data_type": {
+                    "type": "string"
+                },
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                },
+                "sum": {
+                    "type": "number"
+                }
+            }
+        },
+        "health.SleepData": {
+            "type": "object",
+            "properties": {
+                "recorded_date": {
+                    "description": "Date when the sleep data was recorded (YYYY-MM-DD)",
+                    "type": "string"
+                },
+                "sleep_duration": {
+                    "description": "Sleep duration in milliseconds",
+                    "type": "integer"
+                },
+                "sleep_quality": {
+                    "description": "Subjective sleep quality (e.g., \"Good\", \"Average\", \"Poor\")",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.SleepSeries": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "description": "Empty on the last page",
+                    "type": "string"
+                },
+                "sleep_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.SleepData"
+                    }
+                }
+            }
+        },
+        "health.SummaryReport": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "description": "End date in YYYY-MM-DD format, inclusive",
+                    "type": "string"
+                },
+                "records": {
+                    "description": "Only set when the records are requested",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/health.SummaryResponse"
+                        }
+                    ]
+                },
+                "start_date": {
+                    "description": "Start date in YYYY-MM-DD format, inclusive",
+                    "type": "string"
+                },
+                "statistics": {
+                    "$ref": "#/definitions/health.SummaryStatistics"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "health.SummaryResponse": {
             "type": "object",
             "properties": {
@@ -1732,6 +4454,49 @@ const docTemplate = `{
                 }
             }
         },
+        "health.SummaryStatistics": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "$ref": "#/definitions/health.EntityCounts"
+                },
+                "genetic_data_types": {
+                    "description": "Genetic data per data_type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "lifestyle_metrics": {
+                    "description": "Per data_type, numeric values only",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.MetricStatistics"
+                    }
+                },
+                "medical_record_types": {
+                    "description": "Medical records per record_type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "recommendation_types": {
+                    "description": "Recommendations per recommendation_type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "wearable_metrics": {
+                    "description": "Per data_type, numeric values only",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.MetricStatistics"
+                    }
+                }
+            }
+        },
         "health.WearableData": {
             "type": "object",
             "properties": {
@@ -1742,7 +4507,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "data_value": {
-                    "type": "string"
+                    "type": "object"
                 },
                 "device_type": {
                     "type": "string"
@@ -1760,6 +4525,158 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "health.WearableDataAggregate": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "description": "minute, hour or day",
+                    "type": "string"
+                },
+                "buckets": {
+                    "description": "Buckets without samples are omitted",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/health.WearableDataBucket"
+                    }
+                },
+                "data_type": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "description": "IANA time zone the buckets are aligned to",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.WearableDataBucket": {
+            "type": "object",
+            "properties": {
+                "avg": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "end": {
+                    "description": "End of the bucket, exclusive (RFC3339 format, in the requested time zone)",
+                    "type": "string"
+                },
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                },
+                "percentiles": {
+                    "description": "Keyed by function, e.g. \"p95\"",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "start": {
+                    "description": "Start of the bucket, inclusive (RFC3339 format, in the requested time zone)",
+                    "type": "string"
+                }
+            }
+        },
+        "recommend.Condition": {
+            "type": "object",
+            "properties": {
+                "metric": {
+                    "type": "string"
+                },
+                "operator": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "recommend.ConditionResult": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "description": "Actual is the value of the metric, nil when the summary lacks it.",
+                    "type": "number"
+                },
+                "holds": {
+                    "type": "boolean"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "operator": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "recommend.Evaluation": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recommend.ConditionResult"
+                    }
+                },
+                "fired": {
+                    "type": "boolean"
+                },
+                "recommendation": {
+                    "description": "Recommendation is set when the rule fired.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/health.HealthRecommendation"
+                        }
+                    ]
+                },
+                "rule": {
+                    "type": "string"
+                }
+            }
+        },
+        "recommend.Recommendation": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "recommendation_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "recommend.Rule": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recommend.Condition"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "recommendation": {
+                    "$ref": "#/definitions/recommend.Recommendation"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/anomaly"
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+)
+
+// raiseAlerts evaluates an ingested wearable sample against the anomaly rules
+// and publishes the alerts it raises. Failures are logged and never fail the
+// ingestion; samples without a numeric value or timestamp are not evaluated.
+func raiseAlerts(c *gin.Context, kafkaProducer *kafka.Producer, detector *anomaly.Detector, wearableData *health.WearableData) {
+	if detector == nil {
+		return
+	}
+	value, ok := datavalue.Number(wearableData.DataValue)
+	if !ok {
+		return
+	}
+	recordedAt, err := time.Parse(time.RFC3339Nano, wearableData.RecordedTimestamp)
+	if err != nil {
+		return
+	}
+
+	alerts := detector.Evaluate(anomaly.Sample{
+		UserID:     wearableData.UserId,
+		DataType:   wearableData.DataType,
+		DeviceType: wearableData.DeviceType,
+		Value:      value,
+		RecordedAt: recordedAt,
+	})
+	for _, alert := range alerts {
+		metrics.ObserveWearableAlert(alert.Rule, alert.Severity)
+		if err := kafkaProducer.ProduceMessage(c.Request.Context(), kafkaProducer.Cfg.KafkaWearableAlertTopic, "wearable_alert.create", alert); err != nil {
+			slog.ErrorContext(c.Request.Context(), "failed to publish wearable alert",
+				slog.String("rule", alert.Rule),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/helper"
+)
+
+// Limits on the number of events returned by a search.
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+// AuditHandler serves the admin API over the audit log.
+type AuditHandler struct {
+	store *audit.FileSink
+}
+
+// NewAuditHandler creates a new AuditHandler. store may be nil when the file
+// sink is disabled, in which case searches are unavailable.
+func NewAuditHandler(store *audit.FileSink) *AuditHandler {
+	return &AuditHandler{store: store}
+}
+
+// ListAuditEvents godoc
+// @Summary     Search audit events
+// @Description Search PHI access audit events, newest first. Admin only.
+// @Tags        Audit
+// @Accept      json
+// @Produce     json
+// @Param        actor_id   query    string false  "Filter by actor ID"
+// @Param        patient_id query    string false  "Filter by patient user ID"
+// @Param        entity     query    string false  "Filter by entity"
+// @Param        record_id  query    string false  "Filter by record ID"
+// @Param        action     query    string false  "Filter by action (read, list, create, update, delete)"
+// @Param        outcome    query    string false  "Filter by outcome (success, denied, failure)"
+// @Param        from       query    string false  "Events at or after this time (RFC3339 format)"
+// @Param        to         query    string false  "Events at or before this time (RFC3339 format)"
+// @Param        limit      query    int    false  "Maximum number of events, at most 1000" default(100)
+// @Security    ApiKeyAuth
+// @Success     200     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     403     {object} map[string]interface{}
+// @Failure     503     {object} map[string]interface{}
+// @Router      /v1/audit-events [get]
+func (h *AuditHandler) ListAuditEvents(c *gin.Context) {
+	if h.store == nil {
+		c.JSON(http.StatusServiceUnavailable, response.ErrorBody(c, "Audit search requires the file sink"))
+		return
+	}
+
+	filter := audit.Filter{
+		ActorID:   c.Query("actor_id"),
+		PatientID: c.Query("patient_id"),
+		Entity:    c.Query("entity"),
+		RecordID:  c.Query("record_id"),
+		Action:    audit.Action(c.Query("action")),
+		Outcome:   audit.Outcome(c.Query("outcome")),
+		Limit:     int(helper.StringToInt(c.Query("limit"))),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	filter.Limit = min(filter.Limit, maxAuditLimit)
+
+	var err error
+	if from := c.Query("from"); from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, from); err != nil {
+			c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid from "+err.Error()))
+			return
+		}
+	}
+	if to := c.Query("to"); to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, to); err != nil {
+			c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid to "+err.Error()))
+			return
+		}
+	}
+
+	events, err := h.store.Search(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to search audit events "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": events, "count": len(events)})
+}
+
+// VerifyAuditLog godoc
+// @Summary     Verify audit log integrity
+// @Description Walk the hash chain of the audit log and report the first broken link. Admin only.
+// @Tags        Audit
+// @Produce     json
+// @Security    ApiKeyAuth
+// @Success     200     {object} map[string]interface{}
+// @Failure     403     {object} map[string]interface{}
+// @Failure     409     {object} map[string]interface{}
+// @Failure     503     {object} map[string]interface{}
+// @Router      /v1/audit-events/verify [get]
+func (h *AuditHandler) VerifyAuditLog(c *gin.Context) {
+	if h.store == nil {
+		c.JSON(http.StatusServiceUnavailable, response.ErrorBody(c, "Audit verification requires the file sink"))
+		return
+	}
+
+	count, err := h.store.Verify()
+	if err != nil {
+		c.JSON(http.StatusConflict, gin.H{"valid": false, "verified_events": count, "error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"valid": true, "verified_events": count})
+}
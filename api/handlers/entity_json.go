@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+)
+
+// Names of the Any-typed field of the health entities.
+const (
+	dataValueField    = "data_value"
+	dataValueJSONName = "dataValue"
+)
+
+// bindEntity binds the JSON request body into msg with protojson, the way
+// responses are rendered, reading data_value through the datavalue codec.
+func bindEntity(c *gin.Context, msg proto.Message) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+	return decodeEntity(body, msg)
+}
+
+// decodeEntity decodes a JSON entity into msg. Fields are read like protojson
+// writes them, so 64-bit integers may be strings, and unknown fields are
+// ignored. data_value accepts any JSON value, stored according to the
+// entity's data_type.
+func decodeEntity(body []byte, msg proto.Message) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return err
+	}
+
+	// data_value may go by its JSON name as well
+	raw, ok := fields[dataValueField]
+	if camel, found := fields[dataValueJSONName]; found {
+		raw, ok = camel, true
+	}
+	delete(fields, dataValueField)
+	delete(fields, dataValueJSONName)
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(rest, msg); err != nil {
+		return err
+	}
+
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(dataValueField)
+	if !ok || fd == nil || bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return nil
+	}
+
+	dataType := m.Get(m.Descriptor().Fields().ByName("data_type")).String()
+	value, err := datavalue.Decode(raw, dataType)
+	if err != nil {
+		return fmt.Errorf("%s: %w", dataValueField, err)
+	}
+	m.Set(fd, protoreflect.ValueOfMessage(value.ProtoReflect()))
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestDecodeEntity(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    proto.Message
+		wantErr bool
+	}{
+		{
+			name: "proto names",
+			body: `{"id":"m1","user_id":"u1","record_type":"lab_result","doctor_id":"d1"}`,
+			want: &health.MedicalRecord{Id: "m1", UserId: "u1", RecordType: "lab_result", DoctorId: "d1"},
+		},
+		{
+			name: "json names",
+			body: `{"id":"m1","userId":"u1","recordType":"lab_result","doctorId":"d1"}`,
+			want: &health.MedicalRecord{Id: "m1", UserId: "u1", RecordType: "lab_result", DoctorId: "d1"},
+		},
+		{
+			name: "unknown fields are ignored",
+			body: `{"id":"r1","user_id":"u1","priority":2,"source":"rules"}`,
+			want: &health.HealthRecommendation{Id: "r1", UserId: "u1", Priority: 2},
+		},
+		{
+			name:    "wrong type",
+			body:    `{"id":"r1","priority":"high"}`,
+			want:    &health.HealthRecommendation{},
+			wantErr: true,
+		},
+		{
+			name:    "not an object",
+			body:    `[]`,
+			want:    &health.MedicalRecord{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.want.ProtoReflect().New().Interface()
+			err := decodeEntity([]byte(tt.body), got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeEntity() error = %v, want error %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && !proto.Equal(got, tt.want) {
+				t.Errorf("decodeEntity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
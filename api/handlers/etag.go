@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/coalesce"
+	"github.com/health-analytics-service/api-gateway-health-analytics/precondition"
+)
+
+// versioned is implemented by the health entities, whose updated_at serves as
+// their version.
+type versioned interface {
+	GetId() string
+	GetUserId() string
+	GetUpdatedAt() string
+}
+
+// notModified sets the ETag of record and, when the If-None-Match header names
+// it, writes 304 and reports true.
+func notModified(c *gin.Context, record versioned) bool {
+	etag := precondition.ETag(record.GetId(), record.GetUpdatedAt())
+	if etag == "" {
+		return false
+	}
+
+	c.Header("ETag", etag)
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" && precondition.MatchWeak(ifNoneMatch, etag) {
+		c.Status(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+// checkCurrent fetches the stored record a write replaces or deletes, using
+// get, and records its patient, so writes are audited and invalidated under the
+// stored owner rather than the user_id the client sent. It writes 404 when the
+// record does not exist, or 412 when it does not match the If-Match header,
+// and reports false. Otherwise the version the client expects to replace is
+// stored in the request context, for the health service or the Kafka consumer
+// to enforce. The record is always read afresh, never from a coalesced read.
+func checkCurrent(c *gin.Context, get func(ctx context.Context) (versioned, error)) bool {
+	ifMatch := c.GetHeader("If-Match")
+
+	current, err := get(coalesce.Bypass(c.Request.Context()))
+	if err != nil {
+		switch {
+		case status.Code(err) == codes.NotFound && ifMatch != "":
+			c.JSON(http.StatusPreconditionFailed, response.ErrorBody(c, "Precondition failed: record does not exist"))
+		case status.Code(err) == codes.NotFound:
+			c.JSON(http.StatusNotFound, response.ErrorBody(c, "Record not found"))
+		default:
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get current record "+err.Error()))
+		}
+		return false
+	}
+	audit.SetPatient(c, current.GetUserId())
+
+	if ifMatch == "" {
+		return true
+	}
+	etag := precondition.ETag(current.GetId(), current.GetUpdatedAt())
+	if !precondition.MatchStrong(ifMatch, etag) {
+		if etag != "" {
+			c.Header("ETag", etag)
+		}
+		c.JSON(http.StatusPreconditionFailed, response.ErrorBody(c, "Precondition failed: record has been modified"))
+		return false
+	}
+
+	c.Request = c.Request.WithContext(precondition.NewContext(c.Request.Context(), current.GetUpdatedAt()))
+	return true
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/precondition"
+)
+
+func TestCheckCurrent(t *testing.T) {
+	current := &health.MedicalRecord{Id: "m1", UserId: "u1", UpdatedAt: "2026-10-01T10:00:00Z"}
+	etag := precondition.ETag(current.Id, current.UpdatedAt)
+	found := func(context.Context) (versioned, error) { return current, nil }
+	missing := func(context.Context) (versioned, error) { return nil, status.Error(codes.NotFound, "not found") }
+
+	tests := []struct {
+		name        string
+		ifMatch     string
+		get         func(context.Context) (versioned, error)
+		want        bool
+		wantStatus  int
+		wantVersion string
+		wantPatient string
+
+		// clientPatient is the user_id the client sent
+		clientPatient string
+	}{
+		{name: "no precondition", get: found, want: true, wantStatus: http.StatusOK, wantPatient: "u1"},
+		{name: "stored owner", get: found, want: true, wantStatus: http.StatusOK, wantPatient: "u1", clientPatient: "u2"},
+		{name: "missing", get: missing, wantStatus: http.StatusNotFound},
+		{name: "current version", ifMatch: etag, get: found, want: true, wantStatus: http.StatusOK, wantVersion: current.UpdatedAt, wantPatient: "u1"},
+		{name: "any version", ifMatch: "*", get: found, want: true, wantStatus: http.StatusOK, wantVersion: current.UpdatedAt, wantPatient: "u1"},
+		{name: "modified", ifMatch: `"0123"`, get: found, wantStatus: http.StatusPreconditionFailed, wantPatient: "u1"},
+		{name: "weak tag", ifMatch: "W/" + etag, get: found, wantStatus: http.StatusPreconditionFailed, wantPatient: "u1"},
+		{
+			name:       "deleted",
+			ifMatch:    etag,
+			get:        missing,
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "service unavailable",
+			ifMatch:    etag,
+			get:        func(context.Context) (versioned, error) { return nil, errors.New("unavailable") },
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodPut, "/v1/medical-records/m1", nil)
+			audit.SetPatient(c, tt.clientPatient)
+			if tt.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			if got := checkCurrent(c, tt.get); got != tt.want {
+				t.Errorf("checkCurrent() = %t, want %t", got, tt.want)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := precondition.FromContext(c.Request.Context()); got != tt.wantVersion {
+				t.Errorf("expected version = %q, want %q", got, tt.wantVersion)
+			}
+			if got := audit.PatientID(c); got != tt.wantPatient {
+				t.Errorf("patient = %q, want %q", got, tt.wantPatient)
+			}
+		})
+	}
+}
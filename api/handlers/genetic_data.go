@@ -5,26 +5,29 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
 	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
 	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // GeneticDataHandler handles requests related to Genetic Data.
 type GeneticDataHandler struct {
 	kafkaProducer *kafka.Producer
 	service       health.GeneticDataServiceClient
+	renderer      *response.Renderer
 }
 
 // NewGeneticDataHandler creates a new GeneticDataHandler.
-func NewGeneticDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn) *GeneticDataHandler {
+func NewGeneticDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer) *GeneticDataHandler {
 	return &GeneticDataHandler{
 		kafkaProducer: kafkaProducer,
 		service:       health.NewGeneticDataServiceClient(healthGrpcConn),
+		renderer:      renderer,
 	}
 }
 
@@ -42,14 +45,19 @@ func NewGeneticDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.C
 // @Router      /v1/genetic-data [post]
 func (h *GeneticDataHandler) CreateGeneticData(c *gin.Context) {
 	var geneticData health.GeneticData
-	if err := c.ShouldBindJSON(&geneticData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+	if err := bindEntity(c, &geneticData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body"))
 		return
 	}
+	if errs := validation.GeneticData(&geneticData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, geneticData.UserId)
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaGeneticDataTopic, "genetic_data.create", &geneticData); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create genetic data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create genetic data "+err.Error()))
 		return
 	}
 
@@ -63,8 +71,10 @@ func (h *GeneticDataHandler) CreateGeneticData(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Genetic Data ID"
+// @Param       If-None-Match header string false "ETag of a cached copy, answered with 304 while unchanged"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.GeneticData
+// @Header      200     {string} ETag "Version of the record"
 // @Failure     400     {object} map[string]interface{}
 // @Failure     404     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
@@ -73,39 +83,28 @@ func (h *GeneticDataHandler) GetGeneticData(c *gin.Context) {
 	geneticDataID := c.Param("id")
 
 	// Use gRPC to get the genetic data from the service
-	grpcResponse, err := h.service.GetGeneticData(context.Background(), &health.ByIdRequest{Id: geneticDataID})
+	grpcResponse, err := h.service.GetGeneticData(c.Request.Context(), &health.ByIdRequest{Id: geneticDataID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Genetic data not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Genetic data not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get genetic data" + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get genetic data"+err.Error()))
 		return
 	}
 
-	// Convert Any proto message to JSON
-	dataValueJSON, err := protojson.Marshal(grpcResponse.DataValue)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal data value " + err.Error()})
-		return
-	}
+	audit.SetPatient(c, grpcResponse.UserId)
 
-	// Create a response map with the converted data value
-	response := map[string]interface{}{
-		"id":            grpcResponse.Id,
-		"user_id":       grpcResponse.UserId,
-		"data_type":     grpcResponse.DataType,
-		"data_value":    string(dataValueJSON),
-		"analysis_date": grpcResponse.AnalysisDate,
-		"created_at":    grpcResponse.CreatedAt,
-		"updated_at":    grpcResponse.UpdatedAt,
+	// Answer conditional requests for an unchanged record
+	if notModified(c, grpcResponse) {
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
 
 // UpdateGeneticData godoc
@@ -116,34 +115,95 @@ func (h *GeneticDataHandler) GetGeneticData(c *gin.Context) {
 // @Produce     json
 // @Param       id           path     string                   true "Genetic Data ID"
 // @Param       geneticData body     health.GeneticData true "Updated genetic data"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     202     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/genetic-data/{id} [put]
 func (h *GeneticDataHandler) UpdateGeneticData(c *gin.Context) {
 	geneticDataID := c.Param("id")
 	var geneticData health.GeneticData
-	if err := c.ShouldBindJSON(&geneticData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &geneticData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.GeneticData(&geneticData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
 		return
 	}
 
 	// Ensure the ID in the URL matches the ID in the payload
 	if geneticData.Id != geneticDataID {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID mismatch"})
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "ID mismatch"))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetGeneticData(ctx, &health.ByIdRequest{Id: geneticDataID})
+	}) {
 		return
 	}
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaGeneticDataTopic, "genetic_data.update", &geneticData); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update genetic data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to update genetic data "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusAccepted, gin.H{"message": "Genetic data update request accepted"})
 }
 
+// PatchGeneticData godoc
+// @Summary     Patch Genetic Data
+// @Description Partially update a genetic data record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.
+// @Tags        GeneticData
+// @Accept      json
+// @Produce     json
+// @Param       id          path     string true  "Genetic Data ID"
+// @Param       update_mask query    string false "Comma separated fields to update, defaults to the fields in the body"
+// @Param       geneticData body     health.GeneticData true "Fields to update"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/genetic-data/{id} [patch]
+func (h *GeneticDataHandler) PatchGeneticData(c *gin.Context) {
+	geneticDataID := c.Param("id")
+	var geneticData health.GeneticData
+	updateMask, err := bindPatch(c, geneticDataID, &geneticData)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid patch "+err.Error()))
+		return
+	}
+	if errs := validation.GeneticData(&geneticData, updateMask.GetPaths()...); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetGeneticData(ctx, &health.ByIdRequest{Id: geneticDataID})
+	}) {
+		return
+	}
+
+	// Publish only the changed fields and the mask to Kafka
+	patch := &health.GeneticDataPatch{GeneticData: &geneticData, UpdateMask: updateMask}
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaGeneticDataTopic, "genetic_data.patch", patch); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to patch genetic data "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Genetic data patch request accepted"})
+}
+
 // DeleteGeneticData godoc
 // @Summary     Delete Genetic Data
 // @Description Delete a genetic data record by its ID.
@@ -151,32 +211,45 @@ func (h *GeneticDataHandler) UpdateGeneticData(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Genetic Data ID"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     204     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/genetic-data/{id} [delete]
 func (h *GeneticDataHandler) DeleteGeneticData(c *gin.Context) {
 	geneticDataID := c.Param("id")
 
+	// Ensure the record exists and the client is deleting the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetGeneticData(ctx, &health.ByIdRequest{Id: geneticDataID})
+	}) {
+		return
+	}
+
 	// Call gRPC service to delete genetic data
-	_, err := h.service.DeleteGeneticData(context.Background(), &health.ByIdRequest{Id: geneticDataID})
+	_, err := h.service.DeleteGeneticData(c.Request.Context(), &health.ByIdRequest{Id: geneticDataID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Genetic data not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Genetic data not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete genetic data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to delete genetic data "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusNoContent, gin.H{"message": "Genetic data deleted successfully"})
 }
 
+// geneticDataSortFields are the fields ListGeneticData can be sorted by.
+var geneticDataSortFields = []string{"analysis_date", "data_type", "created_at", "updated_at"}
+
 // ListGeneticData godoc
 // @Summary     List Genetic Data
 // @Description Get a list of genetic data records.
@@ -186,38 +259,50 @@ func (h *GeneticDataHandler) DeleteGeneticData(c *gin.Context) {
 // @Param        user_id     query    string false  "Filter by user ID"
 // @Param        data_type   query    string false  "Filter by data type"
 // @Param        analysis_date query string false  "Filter by analysis date (YYYY-MM-DD)"
+// @Param        from       query    string false  "Earliest analysis date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        to         query    string false  "Latest analysis date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -analysis_date)"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.ListGeneticDataResponse
+// @Failure     400     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/genetic-data [get]
 func (h *GeneticDataHandler) ListGeneticData(c *gin.Context) {
 	// Get query parameters for pagination and filtering
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, geneticDataSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
+	analysisDateRange, err := parseDateRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid time range "+err.Error()))
+		return
+	}
+
 	userID := c.Query("user_id")
 	dataType := c.Query("data_type")
 	analysisDate := c.Query("analysis_date")
 
 	// Use gRPC to get the genetic data from the service
-	grpcResponse, err := h.service.ListGeneticData(context.Background(), &health.ListGeneticDataRequest{
-		UserId:       userID,
-		DataType:     dataType,
-		AnalysisDate: analysisDate,
+	grpcResponse, err := h.service.ListGeneticData(c.Request.Context(), &health.ListGeneticDataRequest{
+		UserId:           userID,
+		DataType:         dataType,
+		AnalysisDate:     analysisDate,
+		AnalysisDateFrom: analysisDateRange.From,
+		AnalysisDateTo:   analysisDateRange.To,
+		PageSize:         page.PageSize,
+		PageToken:        page.PageToken,
+		OrderBy:          page.OrderBy,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get genetic data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get genetic data "+err.Error()))
 		return
 	}
 
-	for _, data := range grpcResponse.GeneticData {
-		// Convert Any proto message to JSON
-		dataValueJSON, err := protojson.Marshal(data.DataValue)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal data value " + err.Error()})
-			return
-		}
-		data.DataValue = &anypb.Any{
-			Value: dataValueJSON,
-		}
-	}
-
-	c.JSON(http.StatusOK, grpcResponse)
+	setPageLinks(c, grpcResponse.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
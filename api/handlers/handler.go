@@ -1,10 +1,18 @@
 package handlers
 
 import (
+	"errors"
+
 	"google.golang.org/grpc"
 
+	"github.com/health-analytics-service/api-gateway-health-analytics/anomaly"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
 	"github.com/health-analytics-service/api-gateway-health-analytics/config"
 	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/ratelimit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/recommend"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
 )
 
 // Handler struct holds all the individual entity handlers.
@@ -16,20 +24,118 @@ type Handler struct {
 	MedicalRecordHandler        *MedicalRecordHandler
 	WearableDataHandler         *WearableDataHandler
 	HealthMonitoringHandler     *HealthMonitoringHandler
+	HealthGoalHandler           *HealthGoalHandler
+
+	// Typed vitals handlers.
+	SleepHandler     *SleepHandler
+	HeartRateHandler *HeartRateHandler
+
+	// Rule-based recommendation generation.
+	RecommendationEngineHandler *RecommendationEngineHandler
+
+	// Gateway probes.
+	ProbeHandler *ProbeHandler
+
+	// PHI access auditing.
+	AuditHandler  *AuditHandler
+	AuditRecorder *audit.Recorder
+
+	// Request rate limiting, nil when disabled.
+	RateLimiter *ratelimit.Limiter
+
+	// Health summary caching, nil when disabled.
+	SummaryCache *summary.Cache
+
+	// Wearable anomaly detection, nil when disabled.
+	AnomalyDetector *anomaly.Detector
+
+	// Recommendation rules, nil when disabled.
+	RecommendationEngine *recommend.Engine
+
+	kafkaProducer *kafka.Producer
 }
 
 // It accepts gRPC connections and initializes Kafka producers within each handler.
-func NewHandler(healthGrpcConn *grpc.ClientConn, cfg *config.Config) *Handler {
+func NewHandler(healthGrpcConn *grpc.ClientConn, cfg *config.Config) (*Handler, error) {
 	// Create Kafka producer
 	kafkaProducer := kafka.NewProducer(*cfg)
 
+	// Create audit recorder
+	auditRecorder, err := audit.NewRecorder(*cfg, kafkaProducer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create rate limiter
+	rateLimiter, err := ratelimit.NewLimiter(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create summary cache
+	summaryCache, err := summary.NewCache(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create anomaly detector
+	anomalyDetector, err := anomaly.NewDetector(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create recommendation engine
+	recommendationEngine, err := recommend.NewEngine(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create response renderer
+	renderer := response.NewRenderer(*cfg)
+
+	healthMonitoringHandler := NewHealthMonitoringHandler(healthGrpcConn, renderer, summaryCache, *cfg)
+
 	return &Handler{
 		// Health service handlers.
-		GeneticDataHandler:          NewGeneticDataHandler(kafkaProducer, healthGrpcConn),
-		HealthRecommendationHandler: NewHealthRecommendationHandler(kafkaProducer, healthGrpcConn),
-		LifestyleDataHandler:        NewLifestyleDataHandler(kafkaProducer, healthGrpcConn),
-		MedicalRecordHandler:        NewMedicalRecordHandler(kafkaProducer, healthGrpcConn),
-		WearableDataHandler:         NewWearableDataHandler(kafkaProducer, healthGrpcConn),
-		HealthMonitoringHandler:     NewHealthMonitoringHandler(healthGrpcConn),
-	}
+		GeneticDataHandler:          NewGeneticDataHandler(kafkaProducer, healthGrpcConn, renderer),
+		HealthRecommendationHandler: NewHealthRecommendationHandler(kafkaProducer, healthGrpcConn, renderer),
+		LifestyleDataHandler:        NewLifestyleDataHandler(kafkaProducer, healthGrpcConn, renderer),
+		MedicalRecordHandler:        NewMedicalRecordHandler(kafkaProducer, healthGrpcConn, renderer),
+		WearableDataHandler:         NewWearableDataHandler(kafkaProducer, healthGrpcConn, renderer, anomalyDetector),
+		HealthMonitoringHandler:     healthMonitoringHandler,
+		HealthGoalHandler:           NewHealthGoalHandler(kafkaProducer, healthGrpcConn, renderer),
+
+		// Typed vitals handlers.
+		SleepHandler:     NewSleepHandler(kafkaProducer, healthGrpcConn, renderer),
+		HeartRateHandler: NewHeartRateHandler(kafkaProducer, healthGrpcConn, renderer, anomalyDetector),
+
+		// Rule-based recommendation generation.
+		RecommendationEngineHandler: NewRecommendationEngineHandler(kafkaProducer, recommendationEngine, healthMonitoringHandler),
+
+		// Gateway probes.
+		ProbeHandler: NewProbeHandler(kafkaProducer, healthGrpcConn),
+
+		// PHI access auditing.
+		AuditHandler:  NewAuditHandler(auditRecorder.Store()),
+		AuditRecorder: auditRecorder,
+
+		// Request rate limiting.
+		RateLimiter: rateLimiter,
+
+		// Health summary caching.
+		SummaryCache: summaryCache,
+
+		// Wearable anomaly detection.
+		AnomalyDetector: anomalyDetector,
+
+		// Recommendation rules.
+		RecommendationEngine: recommendationEngine,
+
+		kafkaProducer: kafkaProducer,
+	}, nil
+}
+
+// Close flushes and releases the resources shared by the handlers.
+func (h *Handler) Close() error {
+	return errors.Join(h.RateLimiter.Close(), h.SummaryCache.Close(), h.AnomalyDetector.Close(), h.RecommendationEngine.Close(), h.AuditRecorder.Close(), h.kafkaProducer.Close())
 }
@@ -0,0 +1,315 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HealthGoalHandler handles requests related to Health Goals.
+type HealthGoalHandler struct {
+	kafkaProducer    *kafka.Producer
+	service          health.HealthGoalServiceClient
+	wearableService  health.WearableDataServiceClient
+	lifestyleService health.LifestyleDataServiceClient
+	renderer         *response.Renderer
+}
+
+// NewHealthGoalHandler creates a new HealthGoalHandler.
+func NewHealthGoalHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer) *HealthGoalHandler {
+	return &HealthGoalHandler{
+		kafkaProducer:    kafkaProducer,
+		service:          health.NewHealthGoalServiceClient(healthGrpcConn),
+		wearableService:  health.NewWearableDataServiceClient(healthGrpcConn),
+		lifestyleService: health.NewLifestyleDataServiceClient(healthGrpcConn),
+		renderer:         renderer,
+	}
+}
+
+// CreateHealthGoal godoc
+// @Summary     Create a new Health Goal
+// @Description Create a new health goal, e.g. at least 10000 wearable steps a day or 28800000 ms of lifestyle sleep a day.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param       healthGoal body     health.HealthGoal true "Health Goal details"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals [post]
+func (h *HealthGoalHandler) CreateHealthGoal(c *gin.Context) {
+	var healthGoal health.HealthGoal
+	if err := bindEntity(c, &healthGoal); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.HealthGoal(&healthGoal); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, healthGoal.UserId)
+
+	// Publish to Kafka
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthGoalTopic, "health_goal.create", &healthGoal); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create health goal "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Health goal creation request accepted"})
+}
+
+// GetHealthGoal godoc
+// @Summary     Get Health Goal by ID
+// @Description Get details of a health goal by its ID.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param       id   path     string true "Health Goal ID"
+// @Param       If-None-Match header string false "ETag of a cached copy, answered with 304 while unchanged"
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.HealthGoal
+// @Header      200     {string} ETag "Version of the record"
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals/{id} [get]
+func (h *HealthGoalHandler) GetHealthGoal(c *gin.Context) {
+	healthGoal, ok := h.getHealthGoal(c)
+	if !ok {
+		return
+	}
+
+	// Answer conditional requests for an unchanged record
+	if notModified(c, healthGoal) {
+		return
+	}
+
+	h.renderer.JSON(c, http.StatusOK, healthGoal)
+}
+
+// UpdateHealthGoal godoc
+// @Summary     Update Health Goal
+// @Description Update an existing health goal.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param       id         path     string            true "Health Goal ID"
+// @Param       healthGoal body     health.HealthGoal true "Updated health goal"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals/{id} [put]
+func (h *HealthGoalHandler) UpdateHealthGoal(c *gin.Context) {
+	healthGoalID := c.Param("id")
+	var healthGoal health.HealthGoal
+	if err := bindEntity(c, &healthGoal); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+
+	// Ensure the ID in the URL matches the ID in the payload
+	if healthGoal.Id != healthGoalID {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "ID mismatch"))
+		return
+	}
+	if errs := validation.HealthGoal(&healthGoal); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetHealthGoal(ctx, &health.ByIdRequest{Id: healthGoalID})
+	}) {
+		return
+	}
+
+	// Publish to Kafka
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthGoalTopic, "health_goal.update", &healthGoal); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to update health goal "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Health goal update request accepted"})
+}
+
+// PatchHealthGoal godoc
+// @Summary     Patch Health Goal
+// @Description Partially update a health goal. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param       id          path     string true  "Health Goal ID"
+// @Param       update_mask query    string false "Comma separated fields to update, defaults to the fields in the body"
+// @Param       healthGoal  body     health.HealthGoal true "Fields to update"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals/{id} [patch]
+func (h *HealthGoalHandler) PatchHealthGoal(c *gin.Context) {
+	healthGoalID := c.Param("id")
+	var healthGoal health.HealthGoal
+	updateMask, err := bindPatch(c, healthGoalID, &healthGoal)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid patch "+err.Error()))
+		return
+	}
+	if errs := validation.HealthGoal(&healthGoal, updateMask.GetPaths()...); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetHealthGoal(ctx, &health.ByIdRequest{Id: healthGoalID})
+	}) {
+		return
+	}
+
+	// Publish only the changed fields and the mask to Kafka
+	patch := &health.HealthGoalPatch{HealthGoal: &healthGoal, UpdateMask: updateMask}
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthGoalTopic, "health_goal.patch", patch); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to patch health goal "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Health goal patch request accepted"})
+}
+
+// DeleteHealthGoal godoc
+// @Summary     Delete Health Goal
+// @Description Delete a health goal by its ID.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param       id   path     string true "Health Goal ID"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     204     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals/{id} [delete]
+func (h *HealthGoalHandler) DeleteHealthGoal(c *gin.Context) {
+	healthGoalID := c.Param("id")
+
+	// Ensure the record exists and the client is deleting the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetHealthGoal(ctx, &health.ByIdRequest{Id: healthGoalID})
+	}) {
+		return
+	}
+
+	// Call gRPC service to delete health goal
+	_, err := h.service.DeleteHealthGoal(c.Request.Context(), &health.ByIdRequest{Id: healthGoalID})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.NotFound {
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Health goal not found "+err.Error()))
+				return
+			}
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to delete health goal "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusNoContent, gin.H{"message": "Health goal deleted successfully"})
+}
+
+// healthGoalSortFields are the fields ListHealthGoals can be sorted by.
+var healthGoalSortFields = []string{"start_date", "data_type", "created_at", "updated_at"}
+
+// ListHealthGoals godoc
+// @Summary     List Health Goals
+// @Description Get a list of health goals.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param        user_id    query    string false  "Filter by user ID"
+// @Param        source     query    string false  "Filter by source (wearable or lifestyle)"
+// @Param        data_type  query    string false  "Filter by data type"
+// @Param        period     query    string false  "Filter by period (daily or weekly)"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -start_date)"
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.ListHealthGoalsResponse
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals [get]
+func (h *HealthGoalHandler) ListHealthGoals(c *gin.Context) {
+	// Get query parameters for pagination and filtering
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, healthGoalSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
+	userID := c.Query("user_id")
+	source := c.Query("source")
+	dataType := c.Query("data_type")
+	period := c.Query("period")
+
+	// Use gRPC to get the health goals from the service
+	grpcResponse, err := h.service.ListHealthGoals(c.Request.Context(), &health.ListHealthGoalsRequest{
+		UserId:    userID,
+		Source:    source,
+		DataType:  dataType,
+		Period:    period,
+		PageSize:  page.PageSize,
+		PageToken: page.PageToken,
+		OrderBy:   page.OrderBy,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get health goals "+err.Error()))
+		return
+	}
+
+	setPageLinks(c, grpcResponse.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
+}
+
+// getHealthGoal gets the health goal in the path, writing the error response
+// when it fails.
+func (h *HealthGoalHandler) getHealthGoal(c *gin.Context) (*health.HealthGoal, bool) {
+	healthGoalID := c.Param("id")
+
+	// Use gRPC to get the health goal from the service
+	grpcResponse, err := h.service.GetHealthGoal(c.Request.Context(), &health.ByIdRequest{Id: healthGoalID})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.NotFound {
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Health goal not found "+err.Error()))
+				return nil, false
+			}
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get health goal "+err.Error()))
+		return nil, false
+	}
+
+	audit.SetPatient(c, grpcResponse.UserId)
+	return grpcResponse, true
+}
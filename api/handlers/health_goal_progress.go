@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/goals"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
+)
+
+// GetHealthGoalProgress godoc
+// @Summary     Get Health Goal progress
+// @Description Get the progress of a health goal over each of its periods up to today, with its streaks. Periods older than GOAL_PROGRESS_MAX_DAYS days are left out, and so from the streaks.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param       id   path     string true  "Health Goal ID"
+// @Param       tz   query    string false "IANA time zone days are taken in" default(UTC)
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.HealthGoalProgress
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals/{id}/progress [get]
+func (h *HealthGoalHandler) GetHealthGoalProgress(c *gin.Context) {
+	progress, ok := h.progress(c)
+	if !ok {
+		return
+	}
+
+	h.renderer.JSON(c, http.StatusOK, progress)
+}
+
+// EvaluateHealthGoal godoc
+// @Summary     Evaluate Health Goal
+// @Description Publish a health_goal.achieved or health_goal.missed event for each period of a health goal that ended on or after since, by default the latest one to end. Events of a period keep the same ID across evaluations so consumers can drop repeats; run it after each period ends, e.g. from a daily job. Admins and doctors only.
+// @Tags        HealthGoals
+// @Accept      json
+// @Produce     json
+// @Param       id    path     string true  "Health Goal ID"
+// @Param       since query    string false "Earliest period end date (YYYY-MM-DD)"
+// @Param       tz    query    string false "IANA time zone days are taken in" default(UTC)
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     403     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-goals/{id}/evaluate [post]
+func (h *HealthGoalHandler) EvaluateHealthGoal(c *gin.Context) {
+	var since time.Time
+	if c.Query("since") != "" {
+		var err error
+		if since, err = parseDateParam(c, "since"); err != nil {
+			c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid since "+err.Error()))
+			return
+		}
+	}
+
+	progress, ok := h.progress(c)
+	if !ok {
+		return
+	}
+
+	// Default to the latest period to end
+	if since.IsZero() {
+		for _, period := range progress.Periods {
+			if period.Complete {
+				since, _ = time.Parse(dateLayout, period.EndDate)
+			}
+		}
+	}
+
+	// Publish to Kafka
+	events := goals.Events(progress, since, time.Now())
+	for _, event := range events {
+		if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthGoalEventTopic, "health_goal."+event.Outcome, event); err != nil {
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to publish health goal event "+err.Error()))
+			return
+		}
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Health goal evaluated", "events": len(events)})
+}
+
+// progress computes the progress of the health goal in the path, writing the
+// error response when it fails.
+func (h *HealthGoalHandler) progress(c *gin.Context) (*health.HealthGoalProgress, bool) {
+	loc, err := time.LoadLocation(c.DefaultQuery("tz", "UTC"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid tz "+err.Error()))
+		return nil, false
+	}
+
+	healthGoal, ok := h.getHealthGoal(c)
+	if !ok {
+		return nil, false
+	}
+
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	periods, err := goals.Periods(healthGoal, today, h.kafkaProducer.Cfg.GoalProgressMaxDays)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Invalid health goal "+err.Error()))
+		return nil, false
+	}
+
+	totals := goals.Totals{}
+	if len(periods) > 0 {
+		covered := summary.Period{Start: periods[0].Start, End: periods[len(periods)-1].End}
+		if healthGoal.Source == "lifestyle" {
+			err = h.lifestyleTotals(c, healthGoal, covered, totals)
+		} else {
+			err = h.wearableTotals(c, healthGoal, covered, loc, totals)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get health goal progress "+err.Error()))
+			return nil, false
+		}
+	}
+
+	return goals.Progress(healthGoal, periods, totals, today), true
+}
+
+// wearableTotals adds the numeric wearable data of the goal recorded on the
+// days of covered, taken in loc, to totals.
+func (h *HealthGoalHandler) wearableTotals(c *gin.Context, healthGoal *health.HealthGoal, covered summary.Period, loc *time.Location, totals goals.Totals) error {
+	from := time.Date(covered.Start.Year(), covered.Start.Month(), covered.Start.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(covered.End.Year(), covered.End.Month(), covered.End.Day()+1, 0, 0, 0, 0, loc).Add(-time.Nanosecond)
+
+	req := &health.ListWearableDataRequest{
+		UserId:                healthGoal.UserId,
+		DataType:              healthGoal.DataType,
+		RecordedTimestampFrom: from.UTC().Format(time.RFC3339Nano),
+		RecordedTimestampTo:   to.UTC().Format(time.RFC3339Nano),
+		PageSize:              int32(h.kafkaProducer.Cfg.ListMaxPageSize),
+		OrderBy:               "recorded_timestamp",
+	}
+	for {
+		grpcResponse, err := h.wearableService.ListWearableData(c.Request.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		for _, wearableData := range grpcResponse.WearableData {
+			recorded, err := time.Parse(time.RFC3339Nano, wearableData.RecordedTimestamp)
+			if err != nil {
+				continue
+			}
+			if value, ok := datavalue.Number(wearableData.DataValue); ok {
+				totals.Add(recorded.In(loc), value)
+			}
+		}
+
+		if grpcResponse.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = grpcResponse.NextPageToken
+	}
+}
+
+// lifestyleTotals adds the numeric lifestyle data of the goal recorded on the
+// days of covered to totals.
+func (h *HealthGoalHandler) lifestyleTotals(c *gin.Context, healthGoal *health.HealthGoal, covered summary.Period, totals goals.Totals) error {
+	req := &health.ListLifestyleDataRequest{
+		UserId:           healthGoal.UserId,
+		DataType:         healthGoal.DataType,
+		RecordedDateFrom: covered.Start.Format(dateLayout),
+		RecordedDateTo:   covered.End.Format(dateLayout),
+		PageSize:         int32(h.kafkaProducer.Cfg.ListMaxPageSize),
+		OrderBy:          "recorded_date",
+	}
+	for {
+		grpcResponse, err := h.lifestyleService.ListLifestyleData(c.Request.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		for _, lifestyleData := range grpcResponse.LifestyleData {
+			recorded, err := time.Parse(dateLayout, lifestyleData.RecordedDate)
+			if err != nil {
+				continue
+			}
+			if value, ok := datavalue.Number(lifestyleData.DataValue); ok {
+				totals.Add(recorded, value)
+			}
+		}
+
+		if grpcResponse.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = grpcResponse.NextPageToken
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
 	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
 	"github.com/health-analytics-service/api-gateway-health-analytics/helper"
 	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,13 +20,15 @@ import (
 type HealthRecommendationHandler struct {
 	kafkaProducer *kafka.Producer
 	service       health.HealthRecommendationServiceClient
+	renderer      *response.Renderer
 }
 
 // NewHealthRecommendationHandler creates a new HealthRecommendationHandler.
-func NewHealthRecommendationHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn) *HealthRecommendationHandler {
+func NewHealthRecommendationHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer) *HealthRecommendationHandler {
 	return &HealthRecommendationHandler{
 		kafkaProducer: kafkaProducer,
 		service:       health.NewHealthRecommendationServiceClient(healthGrpcConn),
+		renderer:      renderer,
 	}
 }
 
@@ -41,14 +46,19 @@ func NewHealthRecommendationHandler(kafkaProducer *kafka.Producer, healthGrpcCon
 // @Router      /v1/health-recommendations [post]
 func (h *HealthRecommendationHandler) CreateHealthRecommendation(c *gin.Context) {
 	var healthRecommendation health.HealthRecommendation
-	if err := c.ShouldBindJSON(&healthRecommendation); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &healthRecommendation); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
 		return
 	}
+	if errs := validation.HealthRecommendation(&healthRecommendation); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, healthRecommendation.UserId)
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthRecommendationTopic, "health_recommendation.create", &healthRecommendation); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create health recommendation " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create health recommendation "+err.Error()))
 		return
 	}
 
@@ -62,8 +72,10 @@ func (h *HealthRecommendationHandler) CreateHealthRecommendation(c *gin.Context)
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Health Recommendation ID"
+// @Param       If-None-Match header string false "ETag of a cached copy, answered with 304 while unchanged"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.HealthRecommendation
+// @Header      200     {string} ETag "Version of the record"
 // @Failure     400     {object} map[string]interface{}
 // @Failure     404     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
@@ -72,21 +84,28 @@ func (h *HealthRecommendationHandler) GetHealthRecommendation(c *gin.Context) {
 	healthRecommendationID := c.Param("id")
 
 	// Use gRPC to get the health recommendation from the service
-	grpcResponse, err := h.service.GetHealthRecommendation(context.Background(), &health.ByIdRequest{Id: healthRecommendationID})
+	grpcResponse, err := h.service.GetHealthRecommendation(c.Request.Context(), &health.ByIdRequest{Id: healthRecommendationID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Health recommendation not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Health recommendation not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get health recommendation " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get health recommendation "+err.Error()))
+		return
+	}
+
+	audit.SetPatient(c, grpcResponse.UserId)
+
+	// Answer conditional requests for an unchanged record
+	if notModified(c, grpcResponse) {
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcResponse)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
 
 // UpdateHealthRecommendation godoc
@@ -97,34 +116,95 @@ func (h *HealthRecommendationHandler) GetHealthRecommendation(c *gin.Context) {
 // @Produce     json
 // @Param       id                   path     string                             true "Health Recommendation ID"
 // @Param       healthRecommendation body     health.HealthRecommendation true "Updated health recommendation"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     202     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/health-recommendations/{id} [put]
 func (h *HealthRecommendationHandler) UpdateHealthRecommendation(c *gin.Context) {
 	healthRecommendationID := c.Param("id")
 	var healthRecommendation health.HealthRecommendation
-	if err := c.ShouldBindJSON(&healthRecommendation); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &healthRecommendation); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.HealthRecommendation(&healthRecommendation); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
 		return
 	}
 
 	// Ensure the ID in the URL matches the ID in the payload
 	if healthRecommendation.Id != healthRecommendationID {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID mismatch"})
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "ID mismatch"))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetHealthRecommendation(ctx, &health.ByIdRequest{Id: healthRecommendationID})
+	}) {
 		return
 	}
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthRecommendationTopic, "health_recommendation.update", &healthRecommendation); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update health recommendation " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to update health recommendation "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusAccepted, gin.H{"message": "Health recommendation update request accepted"})
 }
 
+// PatchHealthRecommendation godoc
+// @Summary     Patch Health Recommendation
+// @Description Partially update a health recommendation. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.
+// @Tags        HealthRecommendations
+// @Accept      json
+// @Produce     json
+// @Param       id          path     string true  "Health Recommendation ID"
+// @Param       update_mask query    string false "Comma separated fields to update, defaults to the fields in the body"
+// @Param       healthRecommendation body     health.HealthRecommendation true "Fields to update"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-recommendations/{id} [patch]
+func (h *HealthRecommendationHandler) PatchHealthRecommendation(c *gin.Context) {
+	healthRecommendationID := c.Param("id")
+	var healthRecommendation health.HealthRecommendation
+	updateMask, err := bindPatch(c, healthRecommendationID, &healthRecommendation)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid patch "+err.Error()))
+		return
+	}
+	if errs := validation.HealthRecommendation(&healthRecommendation, updateMask.GetPaths()...); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetHealthRecommendation(ctx, &health.ByIdRequest{Id: healthRecommendationID})
+	}) {
+		return
+	}
+
+	// Publish only the changed fields and the mask to Kafka
+	patch := &health.HealthRecommendationPatch{HealthRecommendation: &healthRecommendation, UpdateMask: updateMask}
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthRecommendationTopic, "health_recommendation.patch", patch); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to patch health recommendation "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Health recommendation patch request accepted"})
+}
+
 // DeleteHealthRecommendation godoc
 // @Summary     Delete Health Recommendation
 // @Description Delete a health recommendation record by its ID.
@@ -132,32 +212,45 @@ func (h *HealthRecommendationHandler) UpdateHealthRecommendation(c *gin.Context)
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Health Recommendation ID"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     204     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/health-recommendations/{id} [delete]
 func (h *HealthRecommendationHandler) DeleteHealthRecommendation(c *gin.Context) {
 	healthRecommendationID := c.Param("id")
 
+	// Ensure the record exists and the client is deleting the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetHealthRecommendation(ctx, &health.ByIdRequest{Id: healthRecommendationID})
+	}) {
+		return
+	}
+
 	// Call gRPC service to delete health recommendation
-	_, err := h.service.DeleteHealthRecommendation(context.Background(), &health.ByIdRequest{Id: healthRecommendationID})
+	_, err := h.service.DeleteHealthRecommendation(c.Request.Context(), &health.ByIdRequest{Id: healthRecommendationID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Health recommendation not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Health recommendation not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete health recommendation " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to delete health recommendation "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusNoContent, gin.H{"message": "Health recommendation deleted successfully"})
 }
 
+// healthRecommendationSortFields are the fields ListHealthRecommendations can be sorted by.
+var healthRecommendationSortFields = []string{"priority", "recommendation_type", "created_at", "updated_at"}
+
 // ListHealthRecommendations godoc
 // @Summary     List Health Recommendations
 // @Description Get a list of health recommendation records.
@@ -167,26 +260,40 @@ func (h *HealthRecommendationHandler) DeleteHealthRecommendation(c *gin.Context)
 // @Param        user_id             query    string false  "Filter by user ID"
 // @Param        recommendation_type query    string false  "Filter by recommendation type"
 // @Param        priority            query    int32   false  "Filter by priority"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -priority)"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.ListHealthRecommendationsResponse
+// @Failure     400     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/health-recommendations [get]
 func (h *HealthRecommendationHandler) ListHealthRecommendations(c *gin.Context) {
 	// Get query parameters for pagination and filtering
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, healthRecommendationSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
 	userID := c.Query("user_id")
 	recommendationType := c.Query("recommendation_type")
 	priority := helper.StringToInt(c.Query("priority"))
 
 	// Use gRPC to get the health recommendations from the service
-	grpcResponse, err := h.service.ListHealthRecommendations(context.Background(), &health.ListHealthRecommendationsRequest{
+	grpcResponse, err := h.service.ListHealthRecommendations(c.Request.Context(), &health.ListHealthRecommendationsRequest{
 		UserId:             userID,
 		RecommendationType: recommendationType,
 		Priority:           priority,
+		PageSize:           page.PageSize,
+		PageToken:          page.PageToken,
+		OrderBy:            page.OrderBy,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get health recommendations " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get health recommendations "+err.Error()))
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcResponse)
+	setPageLinks(c, grpcResponse.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/anomaly"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// heartRateDataType is the wearable data type of heart rate readings.
+	heartRateDataType = "heart_rate"
+	// defaultHeartRateDevice is the device type of readings posted without one.
+	defaultHeartRateDevice = "heart_rate_monitor"
+)
+
+// HeartRateHandler handles requests related to Heart Rate Data, stored as
+// wearable data of type "heart_rate".
+type HeartRateHandler struct {
+	kafkaProducer *kafka.Producer
+	service       health.WearableDataServiceClient
+	renderer      *response.Renderer
+	detector      *anomaly.Detector
+}
+
+// NewHeartRateHandler creates a new HeartRateHandler.
+func NewHeartRateHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer, detector *anomaly.Detector) *HeartRateHandler {
+	return &HeartRateHandler{
+		kafkaProducer: kafkaProducer,
+		service:       health.NewWearableDataServiceClient(healthGrpcConn),
+		renderer:      renderer,
+		detector:      detector,
+	}
+}
+
+// CreateHeartRateData godoc
+// @Summary     Record Heart Rate Data
+// @Description Record a heart rate reading. It is stored as wearable data of type "heart_rate".
+// @Tags        HeartRate
+// @Accept      json
+// @Produce     json
+// @Param       device_type   query    string false "Device that took the reading, defaults to heart_rate_monitor"
+// @Param       heartRateData body     health.HeartRateData true "Heart Rate Data details"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/heart-rate [post]
+func (h *HeartRateHandler) CreateHeartRateData(c *gin.Context) {
+	var heartRateData health.HeartRateData
+	if err := bindEntity(c, &heartRateData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.HeartRateData(&heartRateData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, heartRateData.UserId)
+
+	deviceType := c.DefaultQuery("device_type", defaultHeartRateDevice)
+	if !slices.Contains(validation.DeviceTypes, deviceType) {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid device_type "+deviceType))
+		return
+	}
+
+	dataValue, err := anypb.New(&heartRateData)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create heart rate data "+err.Error()))
+		return
+	}
+	wearableData := health.WearableData{
+		UserId:            heartRateData.UserId,
+		DeviceType:        deviceType,
+		DataType:          heartRateDataType,
+		DataValue:         dataValue,
+		RecordedTimestamp: heartRateData.RecordedTimestamp,
+	}
+
+	// Publish to Kafka
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaWearableDataTopic, "wearable_data.create", &wearableData); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create heart rate data "+err.Error()))
+		return
+	}
+
+	// Alert on anomalous readings
+	raiseAlerts(c, h.kafkaProducer, h.detector, &wearableData)
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Heart rate data creation request accepted"})
+}
+
+// heartRateSortFields are the fields ListHeartRateData can be sorted by.
+var heartRateSortFields = []string{"recorded_timestamp", "device_type", "created_at", "updated_at"}
+
+// ListHeartRateData godoc
+// @Summary     List Heart Rate Data
+// @Description Get the heart rate series of a user.
+// @Tags        HeartRate
+// @Accept      json
+// @Produce     json
+// @Param        user_id     query    string true   "User ID"
+// @Param        device_type query    string false  "Filter by device type"
+// @Param        from       query    string false  "Earliest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        to         query    string false  "Latest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -recorded_timestamp)"
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.HeartRateSeries
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/heart-rate [get]
+func (h *HeartRateHandler) ListHeartRateData(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "user_id is required"))
+		return
+	}
+	audit.SetPatient(c, userID)
+
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, heartRateSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
+	recordedTimestampRange, err := parseTimestampRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid time range "+err.Error()))
+		return
+	}
+
+	// Use gRPC to get the heart rate readings from the wearable data service,
+	// until the page is full of heart rate readings
+	req := &health.ListWearableDataRequest{
+		UserId:                userID,
+		DeviceType:            c.Query("device_type"),
+		DataType:              heartRateDataType,
+		RecordedTimestampFrom: recordedTimestampRange.From,
+		RecordedTimestampTo:   recordedTimestampRange.To,
+		PageToken:             page.PageToken,
+		OrderBy:               page.OrderBy,
+	}
+	series := &health.HeartRateSeries{}
+	for fetches := 0; fetches < maxTypedPageFetches; fetches++ {
+		req.PageSize = page.PageSize - int32(len(series.HeartRateData))
+		grpcResponse, err := h.service.ListWearableData(c.Request.Context(), req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get heart rate data "+err.Error()))
+			return
+		}
+
+		for _, wearableData := range grpcResponse.WearableData {
+			var heartRateData health.HeartRateData
+			// Skip records whose value is not a heart rate reading
+			if err := datavalue.Unpack(wearableData.DataValue, &heartRateData); err != nil || heartRateData.HeartRate == 0 {
+				continue
+			}
+			heartRateData.UserId = wearableData.UserId
+			heartRateData.RecordedTimestamp = wearableData.RecordedTimestamp
+			series.HeartRateData = append(series.HeartRateData, &heartRateData)
+		}
+
+		series.NextPageToken = grpcResponse.NextPageToken
+		req.PageToken = grpcResponse.NextPageToken
+		if req.PageToken == "" || int32(len(series.HeartRateData)) >= page.PageSize {
+			break
+		}
+	}
+
+	setPageLinks(c, series.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, series)
+}
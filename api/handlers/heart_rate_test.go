@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+)
+
+// fakeWearableDataService serves ListWearableData from pages keyed by page
+// token and records the requests it gets.
+type fakeWearableDataService struct {
+	health.WearableDataServiceClient
+	pages    map[string]*health.ListWearableDataResponse
+	requests []*health.ListWearableDataRequest
+}
+
+func (s *fakeWearableDataService) ListWearableData(_ context.Context, in *health.ListWearableDataRequest, _ ...grpc.CallOption) (*health.ListWearableDataResponse, error) {
+	s.requests = append(s.requests, proto.Clone(in).(*health.ListWearableDataRequest))
+	return s.pages[in.PageToken], nil
+}
+
+func TestListHeartRateData(t *testing.T) {
+	steps, err := structpb.NewStruct(map[string]interface{}{"value": 8000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := &fakeWearableDataService{pages: map[string]*health.ListWearableDataResponse{
+		"": {
+			WearableData: []*health.WearableData{
+				{UserId: "u1", RecordedTimestamp: "2026-10-01T08:00:00Z", DataValue: packValue(t, &health.HeartRateData{HeartRate: 64})},
+				{UserId: "u1", RecordedTimestamp: "2026-10-01T08:05:00Z", DataValue: packValue(t, steps)},
+				{UserId: "u1", RecordedTimestamp: "2026-10-01T08:10:00Z", DataValue: packValue(t, &health.HeartRateData{HeartRate: 72})},
+			},
+		},
+	}}
+	h := &HeartRateHandler{kafkaProducer: &kafka.Producer{Cfg: vitalsConfig}, service: service, renderer: response.NewRenderer(vitalsConfig)}
+	c, rec := listContext("/v1/heart-rate?user_id=u1&device_type=smartwatch&from=2026-10-01&to=2026-10-01&sort=-recorded_timestamp")
+	h.ListHeartRateData(c)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	want := &health.ListWearableDataRequest{
+		UserId: "u1", DeviceType: "smartwatch", DataType: heartRateDataType,
+		RecordedTimestampFrom: "2026-10-01T00:00:00Z", RecordedTimestampTo: "2026-10-01T23:59:59.999999999Z",
+		OrderBy: "recorded_timestamp desc", PageSize: 50,
+	}
+	if len(service.requests) != 1 || !proto.Equal(service.requests[0], want) {
+		t.Errorf("requests = %v, want %v", service.requests, want)
+	}
+
+	var series health.HeartRateSeries
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &series); err != nil {
+		t.Fatalf("decode %s: %v", rec.Body, err)
+	}
+	wantSeries := &health.HeartRateSeries{HeartRateData: []*health.HeartRateData{
+		{UserId: "u1", HeartRate: 64, RecordedTimestamp: "2026-10-01T08:00:00Z"},
+		{UserId: "u1", HeartRate: 72, RecordedTimestamp: "2026-10-01T08:10:00Z"},
+	}}
+	if !proto.Equal(&series, wantSeries) {
+		t.Errorf("series = %v, want %v", &series, wantSeries)
+	}
+	if link := rec.Header().Get("Link"); link != "" {
+		t.Errorf("Link = %q for a single page", link)
+	}
+}
+
+func TestListHeartRateDataRejectsInvalidQueries(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantError string
+	}{
+		{name: "no user", query: "device_type=smartwatch", wantError: "user_id is required"},
+		{name: "bad sort", query: "user_id=u1&sort=heart_rate", wantError: "Invalid pagination parameters"},
+		{name: "bad from", query: "user_id=u1&from=today", wantError: "Invalid time range from:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &fakeWearableDataService{}
+			h := &HeartRateHandler{kafkaProducer: &kafka.Producer{Cfg: vitalsConfig}, service: service, renderer: response.NewRenderer(vitalsConfig)}
+			c, rec := listContext("/v1/heart-rate?" + tt.query)
+			h.ListHeartRateData(c)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantError) {
+				t.Errorf("body = %s, want it to contain %q", rec.Body, tt.wantError)
+			}
+			if len(service.requests) != 0 {
+				t.Errorf("service got %d requests, want none", len(service.requests))
+			}
+		})
+	}
+}
+
+func TestCreateHeartRateDataRejectsInvalidBodies(t *testing.T) {
+	const valid = `{"user_id":"u1","heart_rate":64,"recorded_timestamp":"2026-10-01T08:00:00Z"}`
+
+	tests := []struct {
+		name      string
+		query     string
+		body      string
+		wantError string
+	}{
+		{name: "not json", body: `[]`, wantError: "Invalid request body"},
+		{name: "heart rate out of range", body: `{"user_id":"u1","heart_rate":400,"recorded_timestamp":"2026-10-01T08:00:00Z"}`,
+			wantError: `"fields":[{"field":"heart_rate"`},
+		{name: "timestamp format", body: `{"user_id":"u1","heart_rate":64,"recorded_timestamp":"2026-10-01"}`,
+			wantError: `"fields":[{"field":"recorded_timestamp"`},
+		{name: "unknown device", query: "device_type=phone", body: valid, wantError: "Invalid device_type phone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/heart-rate?"+tt.query, strings.NewReader(tt.body))
+
+			// A nil Kafka writer fails the test if the handler publishes
+			h := &HeartRateHandler{kafkaProducer: &kafka.Producer{Cfg: vitalsConfig}, renderer: response.NewRenderer(vitalsConfig)}
+			h.CreateHeartRateData(c)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantError) {
+				t.Errorf("body = %s, want it to contain %s", rec.Body, tt.wantError)
+			}
+		})
+	}
+}
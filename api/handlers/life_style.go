@@ -5,26 +5,29 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
 	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
 	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // LifestyleDataHandler handles requests related to Lifestyle Data.
 type LifestyleDataHandler struct {
 	kafkaProducer *kafka.Producer
 	service       health.LifestyleDataServiceClient
+	renderer      *response.Renderer
 }
 
 // NewLifestyleDataHandler creates a new LifestyleDataHandler.
-func NewLifestyleDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn) *LifestyleDataHandler {
+func NewLifestyleDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer) *LifestyleDataHandler {
 	return &LifestyleDataHandler{
 		kafkaProducer: kafkaProducer,
 		service:       health.NewLifestyleDataServiceClient(healthGrpcConn),
+		renderer:      renderer,
 	}
 }
 
@@ -42,14 +45,19 @@ func NewLifestyleDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc
 // @Router      /v1/lifestyle-data [post]
 func (h *LifestyleDataHandler) CreateLifestyleData(c *gin.Context) {
 	var lifestyleData health.LifestyleData
-	if err := c.ShouldBindJSON(&lifestyleData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+	if err := bindEntity(c, &lifestyleData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body"))
 		return
 	}
+	if errs := validation.LifestyleData(&lifestyleData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, lifestyleData.UserId)
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaLifestyleDataTopic, "lifestyle_data.create", &lifestyleData); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create lifestyle data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create lifestyle data "+err.Error()))
 		return
 	}
 
@@ -63,8 +71,10 @@ func (h *LifestyleDataHandler) CreateLifestyleData(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Lifestyle Data ID"
+// @Param       If-None-Match header string false "ETag of a cached copy, answered with 304 while unchanged"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.LifestyleData
+// @Header      200     {string} ETag "Version of the record"
 // @Failure     400     {object} map[string]interface{}
 // @Failure     404     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
@@ -73,39 +83,28 @@ func (h *LifestyleDataHandler) GetLifestyleData(c *gin.Context) {
 	lifestyleDataID := c.Param("id")
 
 	// Use gRPC to get the lifestyle data from the service
-	grpcResponse, err := h.service.GetLifestyleData(context.Background(), &health.ByIdRequest{Id: lifestyleDataID})
+	grpcResponse, err := h.service.GetLifestyleData(c.Request.Context(), &health.ByIdRequest{Id: lifestyleDataID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Lifestyle data not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Lifestyle data not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get lifestyle data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get lifestyle data "+err.Error()))
 		return
 	}
 
-	// Convert Any proto message to JSON
-	dataValueJSON, err := protojson.Marshal(grpcResponse.DataValue)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal data value"})
-		return
-	}
+	audit.SetPatient(c, grpcResponse.UserId)
 
-	// Create a response map with the converted data value
-	response := map[string]interface{}{
-		"id":            grpcResponse.Id,
-		"user_id":       grpcResponse.UserId,
-		"data_type":     grpcResponse.DataType,
-		"data_value":    string(dataValueJSON),
-		"recorded_date": grpcResponse.RecordedDate,
-		"created_at":    grpcResponse.CreatedAt,
-		"updated_at":    grpcResponse.UpdatedAt,
+	// Answer conditional requests for an unchanged record
+	if notModified(c, grpcResponse) {
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
 
 // UpdateLifestyleData godoc
@@ -116,34 +115,95 @@ func (h *LifestyleDataHandler) GetLifestyleData(c *gin.Context) {
 // @Produce     json
 // @Param       id           path     string                   true "Lifestyle Data ID"
 // @Param       lifestyleData body     health.LifestyleData true "Updated lifestyle data"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     202     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/lifestyle-data/{id} [put]
 func (h *LifestyleDataHandler) UpdateLifestyleData(c *gin.Context) {
 	lifestyleDataID := c.Param("id")
 	var lifestyleData health.LifestyleData
-	if err := c.ShouldBindJSON(&lifestyleData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &lifestyleData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.LifestyleData(&lifestyleData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
 		return
 	}
 
 	// Ensure the ID in the URL matches the ID in the payload
 	if lifestyleData.Id != lifestyleDataID {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID mismatch"})
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "ID mismatch"))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetLifestyleData(ctx, &health.ByIdRequest{Id: lifestyleDataID})
+	}) {
 		return
 	}
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaLifestyleDataTopic, "lifestyle_data.update", &lifestyleData); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update lifestyle data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to update lifestyle data "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusAccepted, gin.H{"message": "Lifestyle data update request accepted"})
 }
 
+// PatchLifestyleData godoc
+// @Summary     Patch Lifestyle Data
+// @Description Partially update a lifestyle data record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.
+// @Tags        LifestyleData
+// @Accept      json
+// @Produce     json
+// @Param       id          path     string true  "Lifestyle Data ID"
+// @Param       update_mask query    string false "Comma separated fields to update, defaults to the fields in the body"
+// @Param       lifestyleData body     health.LifestyleData true "Fields to update"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/lifestyle-data/{id} [patch]
+func (h *LifestyleDataHandler) PatchLifestyleData(c *gin.Context) {
+	lifestyleDataID := c.Param("id")
+	var lifestyleData health.LifestyleData
+	updateMask, err := bindPatch(c, lifestyleDataID, &lifestyleData)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid patch "+err.Error()))
+		return
+	}
+	if errs := validation.LifestyleData(&lifestyleData, updateMask.GetPaths()...); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetLifestyleData(ctx, &health.ByIdRequest{Id: lifestyleDataID})
+	}) {
+		return
+	}
+
+	// Publish only the changed fields and the mask to Kafka
+	patch := &health.LifestyleDataPatch{LifestyleData: &lifestyleData, UpdateMask: updateMask}
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaLifestyleDataTopic, "lifestyle_data.patch", patch); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to patch lifestyle data "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Lifestyle data patch request accepted"})
+}
+
 // DeleteLifestyleData godoc
 // @Summary     Delete Lifestyle Data
 // @Description Delete a lifestyle data record by its ID.
@@ -151,32 +211,45 @@ func (h *LifestyleDataHandler) UpdateLifestyleData(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Lifestyle Data ID"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     204     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/lifestyle-data/{id} [delete]
 func (h *LifestyleDataHandler) DeleteLifestyleData(c *gin.Context) {
 	lifestyleDataID := c.Param("id")
 
+	// Ensure the record exists and the client is deleting the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetLifestyleData(ctx, &health.ByIdRequest{Id: lifestyleDataID})
+	}) {
+		return
+	}
+
 	// Call gRPC service to delete lifestyle data
-	_, err := h.service.DeleteLifestyleData(context.Background(), &health.ByIdRequest{Id: lifestyleDataID})
+	_, err := h.service.DeleteLifestyleData(c.Request.Context(), &health.ByIdRequest{Id: lifestyleDataID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Lifestyle data not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Lifestyle data not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete lifestyle data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to delete lifestyle data "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusNoContent, gin.H{"message": "Lifestyle data deleted successfully"})
 }
 
+// lifestyleDataSortFields are the fields ListLifestyleData can be sorted by.
+var lifestyleDataSortFields = []string{"recorded_date", "data_type", "created_at", "updated_at"}
+
 // ListLifestyleData godoc
 // @Summary     List Lifestyle Data
 // @Description Get a list of lifestyle data records.
@@ -186,38 +259,50 @@ func (h *LifestyleDataHandler) DeleteLifestyleData(c *gin.Context) {
 // @Param        user_id     query    string false  "Filter by user ID"
 // @Param        data_type   query    string false  "Filter by data type"
 // @Param        recorded_date query string false  "Filter by recorded date (YYYY-MM-DD)"
+// @Param        from       query    string false  "Earliest recorded date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        to         query    string false  "Latest recorded date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -recorded_date)"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.ListLifestyleDataResponse
+// @Failure     400     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/lifestyle-data [get]
 func (h *LifestyleDataHandler) ListLifestyleData(c *gin.Context) {
 	// Get query parameters for pagination and filtering
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, lifestyleDataSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
+	recordedDateRange, err := parseDateRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid time range "+err.Error()))
+		return
+	}
+
 	userID := c.Query("user_id")
 	dataType := c.Query("data_type")
 	recordedDate := c.Query("recorded_date")
 
 	// Use gRPC to get the lifestyle data from the service
-	grpcResponse, err := h.service.ListLifestyleData(context.Background(), &health.ListLifestyleDataRequest{
-		UserId:       userID,
-		DataType:     dataType,
-		RecordedDate: recordedDate,
+	grpcResponse, err := h.service.ListLifestyleData(c.Request.Context(), &health.ListLifestyleDataRequest{
+		UserId:           userID,
+		DataType:         dataType,
+		RecordedDate:     recordedDate,
+		RecordedDateFrom: recordedDateRange.From,
+		RecordedDateTo:   recordedDateRange.To,
+		PageSize:         page.PageSize,
+		PageToken:        page.PageToken,
+		OrderBy:          page.OrderBy,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get lifestyle data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get lifestyle data "+err.Error()))
 		return
 	}
 
-	for i, data := range grpcResponse.LifestyleData {
-		// Convert Any proto message to JSON
-		dataValueJSON, err := protojson.Marshal(data.DataValue)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal data value " + err.Error()})
-			return
-		}
-		grpcResponse.LifestyleData[i].DataValue = &anypb.Any{
-			Value: dataValueJSON,
-		}
-	}
-
-	c.JSON(http.StatusOK, grpcResponse)
+	setPageLinks(c, grpcResponse.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
@@ -5,8 +5,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
 	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
 	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,13 +19,15 @@ import (
 type MedicalRecordHandler struct {
 	kafkaProducer *kafka.Producer
 	service       health.MedicalRecordServiceClient
+	renderer      *response.Renderer
 }
 
 // NewMedicalRecordHandler creates a new MedicalRecordHandler.
-func NewMedicalRecordHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn) *MedicalRecordHandler {
+func NewMedicalRecordHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer) *MedicalRecordHandler {
 	return &MedicalRecordHandler{
 		kafkaProducer: kafkaProducer,
 		service:       health.NewMedicalRecordServiceClient(healthGrpcConn),
+		renderer:      renderer,
 	}
 }
 
@@ -40,14 +45,19 @@ func NewMedicalRecordHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc
 // @Router      /v1/medical-records [post]
 func (h *MedicalRecordHandler) CreateMedicalRecord(c *gin.Context) {
 	var medicalRecord health.MedicalRecord
-	if err := c.ShouldBindJSON(&medicalRecord); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &medicalRecord); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
 		return
 	}
+	if errs := validation.MedicalRecord(&medicalRecord); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, medicalRecord.UserId)
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaMedicalRecordTopic, "medical_record.create", &medicalRecord); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create medical record " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create medical record "+err.Error()))
 		return
 	}
 
@@ -61,8 +71,10 @@ func (h *MedicalRecordHandler) CreateMedicalRecord(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Medical Record ID"
+// @Param       If-None-Match header string false "ETag of a cached copy, answered with 304 while unchanged"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.MedicalRecord
+// @Header      200     {string} ETag "Version of the record"
 // @Failure     400     {object} map[string]interface{}
 // @Failure     404     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
@@ -71,21 +83,28 @@ func (h *MedicalRecordHandler) GetMedicalRecord(c *gin.Context) {
 	medicalRecordID := c.Param("id")
 
 	// Use gRPC to get the medical record from the service
-	grpcResponse, err := h.service.GetMedicalRecord(context.Background(), &health.ByIdRequest{Id: medicalRecordID})
+	grpcResponse, err := h.service.GetMedicalRecord(c.Request.Context(), &health.ByIdRequest{Id: medicalRecordID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Medical record not found" + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Medical record not found"+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get medical record " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get medical record "+err.Error()))
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcResponse)
+	audit.SetPatient(c, grpcResponse.UserId)
+
+	// Answer conditional requests for an unchanged record
+	if notModified(c, grpcResponse) {
+		return
+	}
+
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
 
 // UpdateMedicalRecord godoc
@@ -96,34 +115,95 @@ func (h *MedicalRecordHandler) GetMedicalRecord(c *gin.Context) {
 // @Produce     json
 // @Param       id           path     string                   true "Medical Record ID"
 // @Param       medicalRecord body     health.MedicalRecord true "Updated medical record"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     202     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/medical-records/{id} [put]
 func (h *MedicalRecordHandler) UpdateMedicalRecord(c *gin.Context) {
 	medicalRecordID := c.Param("id")
 	var medicalRecord health.MedicalRecord
-	if err := c.ShouldBindJSON(&medicalRecord); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &medicalRecord); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.MedicalRecord(&medicalRecord); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
 		return
 	}
 
 	// Ensure the ID in the URL matches the ID in the payload
 	if medicalRecord.Id != medicalRecordID {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID mismatch"})
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "ID mismatch"))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetMedicalRecord(ctx, &health.ByIdRequest{Id: medicalRecordID})
+	}) {
 		return
 	}
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaMedicalRecordTopic, "medical_record.update", &medicalRecord); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update medical record " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to update medical record "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusAccepted, gin.H{"message": "Medical record update request accepted"})
 }
 
+// PatchMedicalRecord godoc
+// @Summary     Patch Medical Record
+// @Description Partially update a medical record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.
+// @Tags        MedicalRecords
+// @Accept      json
+// @Produce     json
+// @Param       id          path     string true  "Medical Record ID"
+// @Param       update_mask query    string false "Comma separated fields to update, defaults to the fields in the body"
+// @Param       medicalRecord body     health.MedicalRecord true "Fields to update"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/medical-records/{id} [patch]
+func (h *MedicalRecordHandler) PatchMedicalRecord(c *gin.Context) {
+	medicalRecordID := c.Param("id")
+	var medicalRecord health.MedicalRecord
+	updateMask, err := bindPatch(c, medicalRecordID, &medicalRecord)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid patch "+err.Error()))
+		return
+	}
+	if errs := validation.MedicalRecord(&medicalRecord, updateMask.GetPaths()...); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetMedicalRecord(ctx, &health.ByIdRequest{Id: medicalRecordID})
+	}) {
+		return
+	}
+
+	// Publish only the changed fields and the mask to Kafka
+	patch := &health.MedicalRecordPatch{MedicalRecord: &medicalRecord, UpdateMask: updateMask}
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaMedicalRecordTopic, "medical_record.patch", patch); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to patch medical record "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Medical record patch request accepted"})
+}
+
 // DeleteMedicalRecord godoc
 // @Summary     Delete Medical Record
 // @Description Delete a medical record by its ID.
@@ -131,32 +211,45 @@ func (h *MedicalRecordHandler) UpdateMedicalRecord(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Medical Record ID"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     204     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/medical-records/{id} [delete]
 func (h *MedicalRecordHandler) DeleteMedicalRecord(c *gin.Context) {
 	medicalRecordID := c.Param("id")
 
+	// Ensure the record exists and the client is deleting the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetMedicalRecord(ctx, &health.ByIdRequest{Id: medicalRecordID})
+	}) {
+		return
+	}
+
 	// Call gRPC service to delete medical record
-	_, err := h.service.DeleteMedicalRecord(context.Background(), &health.ByIdRequest{Id: medicalRecordID})
+	_, err := h.service.DeleteMedicalRecord(c.Request.Context(), &health.ByIdRequest{Id: medicalRecordID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Medical record not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Medical record not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete medical record " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to delete medical record "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusNoContent, gin.H{"message": "Medical record deleted successfully"})
 }
 
+// medicalRecordSortFields are the fields ListMedicalRecords can be sorted by.
+var medicalRecordSortFields = []string{"record_date", "record_type", "created_at", "updated_at"}
+
 // ListMedicalRecords godoc
 // @Summary     List Medical Records
 // @Description Get a list of medical records.
@@ -168,12 +261,30 @@ func (h *MedicalRecordHandler) DeleteMedicalRecord(c *gin.Context) {
 // @Param        record_date query    string false  "Filter by record date (YYYY-MM-DD)"
 // @Param        description query    string false  "Filter by description"
 // @Param        doctor_id   query    string false  "Filter by doctor ID"
+// @Param        from       query    string false  "Earliest record date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        to         query    string false  "Latest record date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -record_date)"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.ListMedicalRecordsResponse
+// @Failure     400     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/medical-records [get]
 func (h *MedicalRecordHandler) ListMedicalRecords(c *gin.Context) {
 	// Get query parameters for pagination and filtering
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, medicalRecordSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
+	recordDateRange, err := parseDateRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid time range "+err.Error()))
+		return
+	}
+
 	userID := c.Query("user_id")
 	recordType := c.Query("record_type")
 	recordDate := c.Query("record_date")
@@ -181,17 +292,23 @@ func (h *MedicalRecordHandler) ListMedicalRecords(c *gin.Context) {
 	doctorID := c.Query("doctor_id")
 
 	// Use gRPC to get the medical records from the service
-	grpcResponse, err := h.service.ListMedicalRecords(context.Background(), &health.ListMedicalRecordsRequest{
-		UserId:      userID,
-		RecordType:  recordType,
-		RecordDate:  recordDate,
-		Description: description,
-		DoctorId:    doctorID,
+	grpcResponse, err := h.service.ListMedicalRecords(c.Request.Context(), &health.ListMedicalRecordsRequest{
+		UserId:         userID,
+		RecordType:     recordType,
+		RecordDate:     recordDate,
+		Description:    description,
+		DoctorId:       doctorID,
+		RecordDateFrom: recordDateRange.From,
+		RecordDateTo:   recordDateRange.To,
+		PageSize:       page.PageSize,
+		PageToken:      page.PageToken,
+		OrderBy:        page.OrderBy,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get medical records " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get medical records "+err.Error()))
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcResponse)
+	setPageLinks(c, grpcResponse.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
@@ -2,22 +2,42 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
 	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 )
 
+const (
+	// monthLayout is the format of the month of a monthly summary.
+	monthLayout = "2006-01"
+	// summaryConcurrency caps the weekly summaries fetched at once for a
+	// longer period.
+	summaryConcurrency = 4
+)
+
 // HealthMonitoringHandler handles requests related to Health Monitoring.
 type HealthMonitoringHandler struct {
-	service health.HealthMonitoringServiceClient
+	service  health.HealthMonitoringServiceClient
+	renderer *response.Renderer
+	cache    *summary.Cache
+	cfg      config.Config
 }
 
 // NewHealthMonitoringHandler creates a new HealthMonitoringHandler.
-func NewHealthMonitoringHandler(healthGrpcConn *grpc.ClientConn) *HealthMonitoringHandler {
+func NewHealthMonitoringHandler(healthGrpcConn *grpc.ClientConn, renderer *response.Renderer, cache *summary.Cache, cfg config.Config) *HealthMonitoringHandler {
 	return &HealthMonitoringHandler{
-		service: health.NewHealthMonitoringServiceClient(healthGrpcConn),
+		service:  health.NewHealthMonitoringServiceClient(healthGrpcConn),
+		renderer: renderer,
+		cache:    cache,
+		cfg:      cfg,
 	}
 }
 
@@ -36,24 +56,25 @@ func NewHealthMonitoringHandler(healthGrpcConn *grpc.ClientConn) *HealthMonitori
 // @Router      /v1/health-monitoring/daily-summary/{user_id} [get]
 func (h *HealthMonitoringHandler) GetDailySummary(c *gin.Context) {
 	userID := c.Param("user_id")
-	date := c.Query("date")
+	date, err := parseDateParam(c, "date")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid date "+err.Error()))
+		return
+	}
 
-	// Use gRPC to get the daily summary from the service
-	grpcResponse, err := h.service.GetDailySummary(context.Background(), &health.DailySummaryRequest{
-		UserId: userID,
-		Date:   date,
-	})
+	// Use gRPC to get the daily summary from the service, unless cached
+	grpcResponse, err := h.dailySummary(c.Request.Context(), userID, date)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get daily summary " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get daily summary "+err.Error()))
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcResponse)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
 
 // GetWeeklySummary godoc
 // @Summary     Get Weekly Summary
-// @Description Get a weekly summary of health data for a user.
+// @Description Get a weekly summary of health data for a user. The range spans at most 7 days.
 // @Tags        HealthMonitoring
 // @Accept      json
 // @Produce     json
@@ -67,19 +88,171 @@ func (h *HealthMonitoringHandler) GetDailySummary(c *gin.Context) {
 // @Router      /v1/health-monitoring/weekly-summary/{user_id} [get]
 func (h *HealthMonitoringHandler) GetWeeklySummary(c *gin.Context) {
 	userID := c.Param("user_id")
-	startDate := c.Query("start_date")
-	endDate := c.Query("end_date")
-
-	// Use gRPC to get the weekly summary from the service
-	grpcResponse, err := h.service.GetWeeklySummary(context.Background(), &health.WeeklySummaryRequest{
-		UserId:    userID,
-		StartDate: startDate,
-		EndDate:   endDate,
-	})
+	period, err := parseSummaryPeriod(c, summary.WeekDays)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid date range "+err.Error()))
+		return
+	}
+
+	// Use gRPC to get the weekly summary from the service, unless cached
+	grpcResponse, err := h.weeklySummary(c.Request.Context(), userID, period)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get weekly summary "+err.Error()))
+		return
+	}
+
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
+}
+
+// GetMonthlySummary godoc
+// @Summary     Get Monthly Summary
+// @Description Get the per-entity counts and statistics of a calendar month of health data for a user.
+// @Tags        HealthMonitoring
+// @Accept      json
+// @Produce     json
+// @Param       user_id         path     string true  "User ID"
+// @Param       month           query    string true  "Month (YYYY-MM)"
+// @Param       include_records query    bool   false "Include the summarized records"
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.SummaryReport
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-monitoring/monthly-summary/{user_id} [get]
+func (h *HealthMonitoringHandler) GetMonthlySummary(c *gin.Context) {
+	month, err := time.Parse(monthLayout, c.Query("month"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid month, expected YYYY-MM"))
+		return
+	}
+
+	h.summaryReport(c, summary.Month(month))
+}
+
+// GetRangeSummary godoc
+// @Summary     Get Range Summary
+// @Description Get the per-entity counts and statistics of an arbitrary range of health data for a user. The range spans at most SUMMARY_MAX_RANGE_DAYS days, 92 by default.
+// @Tags        HealthMonitoring
+// @Accept      json
+// @Produce     json
+// @Param       user_id         path     string true  "User ID"
+// @Param       start_date      query    string true  "Start Date (YYYY-MM-DD)"
+// @Param       end_date        query    string true  "End Date (YYYY-MM-DD)"
+// @Param       include_records query    bool   false "Include the summarized records"
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.SummaryReport
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/health-monitoring/summary/{user_id} [get]
+func (h *HealthMonitoringHandler) GetRangeSummary(c *gin.Context) {
+	period, err := parseSummaryPeriod(c, h.cfg.SummaryMaxRangeDays)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid date range "+err.Error()))
+		return
+	}
+
+	h.summaryReport(c, period)
+}
+
+// summaryReport writes the report of period for the user in the path.
+func (h *HealthMonitoringHandler) summaryReport(c *gin.Context, period summary.Period) {
+	userID := c.Param("user_id")
+	records, err := h.periodSummary(c.Request.Context(), userID, period)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get weekly summary " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get summary "+err.Error()))
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcResponse)
+	report := &health.SummaryReport{
+		UserId:     userID,
+		StartDate:  period.Start.Format(dateLayout),
+		EndDate:    period.End.Format(dateLayout),
+		Statistics: summary.Statistics(records),
+	}
+	if c.Query("include_records") == "true" {
+		report.Records = records
+	}
+
+	h.renderer.JSON(c, http.StatusOK, report)
+}
+
+// periodSummary gets the records of period by merging the weekly summaries
+// covering it.
+func (h *HealthMonitoringHandler) periodSummary(ctx context.Context, userID string, period summary.Period) (*health.SummaryResponse, error) {
+	weeks := period.Split(summary.WeekDays)
+	responses := make([]*health.SummaryResponse, len(weeks))
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(summaryConcurrency)
+	for i, week := range weeks {
+		group.Go(func() error {
+			grpcResponse, err := h.weeklySummary(ctx, userID, week)
+			responses[i] = grpcResponse
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	merged := &health.SummaryResponse{}
+	for _, grpcResponse := range responses {
+		summary.Merge(merged, grpcResponse)
+	}
+	return merged, nil
+}
+
+// dailySummary gets the daily summary of date, through the cache.
+func (h *HealthMonitoringHandler) dailySummary(ctx context.Context, userID string, date time.Time) (*health.SummaryResponse, error) {
+	return h.cache.Fetch(ctx, summary.KindDaily, userID, summary.Period{Start: date, End: date}, func(ctx context.Context) (*health.SummaryResponse, error) {
+		return h.service.GetDailySummary(ctx, &health.DailySummaryRequest{
+			UserId: userID,
+			Date:   date.Format(dateLayout),
+		})
+	})
+}
+
+// weeklySummary gets the weekly summary of period, through the cache.
+func (h *HealthMonitoringHandler) weeklySummary(ctx context.Context, userID string, period summary.Period) (*health.SummaryResponse, error) {
+	return h.cache.Fetch(ctx, summary.KindWeekly, userID, period, func(ctx context.Context) (*health.SummaryResponse, error) {
+		return h.service.GetWeeklySummary(ctx, &health.WeeklySummaryRequest{
+			UserId:    userID,
+			StartDate: period.Start.Format(dateLayout),
+			EndDate:   period.End.Format(dateLayout),
+		})
+	})
+}
+
+// parseSummaryPeriod reads the start_date and end_date query parameters of a
+// summary spanning at most maxDays days.
+func parseSummaryPeriod(c *gin.Context, maxDays int) (summary.Period, error) {
+	start, err := parseDateParam(c, "start_date")
+	if err != nil {
+		return summary.Period{}, err
+	}
+	end, err := parseDateParam(c, "end_date")
+	if err != nil {
+		return summary.Period{}, err
+	}
+
+	period := summary.Period{Start: start, End: end}
+	if end.Before(start) {
+		return summary.Period{}, fmt.Errorf("start_date must not be after end_date")
+	}
+	if days := period.Days(); days > maxDays {
+		return summary.Period{}, fmt.Errorf("range spans %d days, at most %d are allowed", days, maxDays)
+	}
+	return period, nil
+}
+
+// parseDateParam reads the required YYYY-MM-DD query parameter name.
+func parseDateParam(c *gin.Context, name string) (time.Time, error) {
+	value := c.Query(name)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%s is required", name)
+	}
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be a date in YYYY-MM-DD format", name)
+	}
+	return date, nil
 }
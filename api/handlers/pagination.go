@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+)
+
+// maxTypedPageFetches caps the service pages the typed vitals listings read to
+// fill one page, when most records are filtered out. The page is then returned
+// short, with the token to resume from.
+const maxTypedPageFetches = 10
+
+// pageQuery holds the pagination and sorting parameters shared by the List
+// endpoints:
+//
+//	limit       page size, defaulting to LIST_DEFAULT_PAGE_SIZE and capped at LIST_MAX_PAGE_SIZE
+//	page_token  next_page_token of the previous page
+//	sort        comma separated fields, "-" prefixed for descending order
+type pageQuery struct {
+	PageSize  int32
+	PageToken string
+	OrderBy   string
+}
+
+// parsePageQuery reads the pagination parameters of a List request, accepting
+// only the given sort fields.
+func parsePageQuery(c *gin.Context, cfg config.Config, sortFields ...string) (pageQuery, error) {
+	query := pageQuery{
+		PageSize:  int32(cfg.ListDefaultPageSize),
+		PageToken: c.Query("page_token"),
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		size, err := strconv.Atoi(limit)
+		if err != nil || size <= 0 {
+			return pageQuery{}, fmt.Errorf("limit must be a positive integer")
+		}
+		query.PageSize = int32(min(size, cfg.ListMaxPageSize))
+	}
+
+	if sort := c.Query("sort"); sort != "" {
+		var orderBy []string
+		for _, field := range strings.Split(sort, ",") {
+			field = strings.TrimSpace(field)
+			name, desc := strings.CutPrefix(field, "-")
+			if !slices.Contains(sortFields, name) {
+				return pageQuery{}, fmt.Errorf("cannot sort by %q, expected one of %s", name, strings.Join(sortFields, ", "))
+			}
+			if desc {
+				name += " desc"
+			}
+			orderBy = append(orderBy, name)
+		}
+		query.OrderBy = strings.Join(orderBy, ",")
+	}
+
+	return query, nil
+}
+
+// setPageLinks sets the RFC 8288 Link header pointing to the first and next
+// pages of the current listing.
+func setPageLinks(c *gin.Context, nextPageToken string) {
+	var links []string
+	if c.Query("page_token") != "" {
+		links = append(links, fmt.Sprintf(`<%s>; rel="first"`, pageURL(c.Request, "")))
+	}
+	if nextPageToken != "" {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageURL(c.Request, nextPageToken)))
+	}
+	if len(links) > 0 {
+		c.Header("Link", strings.Join(links, ", "))
+	}
+}
+
+// pageURL returns the request URL with its page token replaced.
+func pageURL(r *http.Request, pageToken string) string {
+	query := r.URL.Query()
+	query.Del("page_token")
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+
+	u := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	return u.String()
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+)
+
+// listContext returns a context for a GET of target.
+func listContext(target string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	return c, rec
+}
+
+func TestParsePageQuery(t *testing.T) {
+	cfg := config.Config{ListDefaultPageSize: 50, ListMaxPageSize: 500}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    pageQuery
+		wantErr string
+	}{
+		{name: "defaults", want: pageQuery{PageSize: 50}},
+		{name: "limit and token", query: "limit=20&page_token=abc", want: pageQuery{PageSize: 20, PageToken: "abc"}},
+		{name: "limit capped", query: "limit=100000", want: pageQuery{PageSize: 500}},
+		{name: "zero limit", query: "limit=0", wantErr: "limit must be a positive integer"},
+		{name: "negative limit", query: "limit=-1", wantErr: "limit must be a positive integer"},
+		{name: "limit not a number", query: "limit=ten", wantErr: "limit must be a positive integer"},
+		{name: "ascending", query: "sort=record_date", want: pageQuery{PageSize: 50, OrderBy: "record_date"}},
+		{name: "descending", query: "sort=-record_date", want: pageQuery{PageSize: 50, OrderBy: "record_date desc"}},
+		{name: "several fields", query: "sort=-record_date,%20created_at", want: pageQuery{PageSize: 50, OrderBy: "record_date desc,created_at"}},
+		{name: "unknown field", query: "sort=doctor_id", wantErr: `cannot sort by "doctor_id", expected one of record_date, created_at`},
+		{name: "empty field", query: "sort=record_date,", wantErr: `cannot sort by "", expected one of record_date, created_at`},
+		{name: "order by injection", query: "sort=record_date%3Bdrop", wantErr: `cannot sort by "record_date;drop", expected one of record_date, created_at`},
+	}
+
+	for _, tt := range tests {
+		c, _ := listContext("/v1/medical-records?" + tt.query)
+		got, err := parsePageQuery(c, cfg, "record_date", "created_at")
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("%s: parsePageQuery() error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: parsePageQuery() error = %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: parsePageQuery() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSetPageLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		nextPageToken string
+		want          string
+	}{
+		{name: "single page", target: "/v1/users/u1/medical-records?limit=20"},
+		{name: "first page", target: "/v1/users/u1/medical-records?limit=20&sort=-record_date", nextPageToken: "p2",
+			want: `</v1/users/u1/medical-records?limit=20&page_token=p2&sort=-record_date>; rel="next"`},
+		{name: "middle page", target: "/v1/users/u1/medical-records?page_token=p2&limit=20", nextPageToken: "p3",
+			want: `</v1/users/u1/medical-records?limit=20>; rel="first", </v1/users/u1/medical-records?limit=20&page_token=p3>; rel="next"`},
+		{name: "last page", target: "/v1/users/u1/medical-records?page_token=p3",
+			want: `</v1/users/u1/medical-records>; rel="first"`},
+		{name: "token escaped", target: "/v1/users/u1/medical-records", nextPageToken: "a+b/c=",
+			want: `</v1/users/u1/medical-records?page_token=a%2Bb%2Fc%3D>; rel="next"`},
+	}
+
+	for _, tt := range tests {
+		c, rec := listContext(tt.target)
+		setPageLinks(c, tt.nextPageToken)
+		if got := rec.Header().Get("Link"); got != tt.want {
+			t.Errorf("%s: Link = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
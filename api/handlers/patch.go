@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// immutableFields cannot be changed through PATCH. user_id is among them so a
+// patch cannot move a record to another patient.
+var immutableFields = []string{"id", "user_id", "created_at", "updated_at"}
+
+// bindPatch reads the partial update of the record identified by id into msg.
+//
+// The body is a JSON Merge Patch (RFC 7396): fields present are set and null
+// fields are cleared. An explicit update_mask query parameter restricts the
+// update to the listed fields instead, clearing those absent from the body.
+// Fields may be named by their proto or JSON name, as protojson accepts both.
+// On return msg only holds the fields in the returned mask, with its id set.
+func bindPatch(c *gin.Context, id string, msg proto.Message) (*fieldmaskpb.FieldMask, error) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("body must be a JSON object: %w", err)
+	}
+	if err := decodeEntity(body, msg); err != nil {
+		return nil, err
+	}
+
+	// Ensure the ID in the URL matches the ID in the payload
+	descriptor := msg.ProtoReflect().Descriptor()
+	if raw, ok := fields["id"]; ok {
+		var bodyID string
+		if err := json.Unmarshal(raw, &bodyID); err != nil || bodyID != id {
+			return nil, fmt.Errorf("ID mismatch")
+		}
+		delete(fields, "id")
+	}
+
+	var paths []string
+	if mask := c.Query("update_mask"); mask != "" {
+		for _, path := range strings.Split(mask, ",") {
+			paths = append(paths, fieldPath(descriptor, strings.TrimSpace(path)))
+		}
+	} else {
+		for key := range fields {
+			paths = append(paths, fieldPath(descriptor, key))
+		}
+		slices.Sort(paths)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no fields to update")
+	}
+
+	for _, path := range paths {
+		if strings.Contains(path, ".") {
+			return nil, fmt.Errorf("nested field %q cannot be updated, update %q instead", path, strings.Split(path, ".")[0])
+		}
+		if slices.Contains(immutableFields, path) {
+			return nil, fmt.Errorf("field %q cannot be updated", path)
+		}
+	}
+	updateMask, err := fieldmaskpb.New(msg, paths...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid update mask: %w", err)
+	}
+	updateMask.Normalize()
+
+	// Keep only the masked fields so the update carries nothing else
+	m := msg.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !slices.Contains(updateMask.GetPaths(), string(fd.Name())) {
+			m.Clear(fd)
+		}
+		return true
+	})
+	m.Set(m.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString(id))
+
+	return updateMask, nil
+}
+
+// fieldPath returns the proto name of the field of desc named key, by its proto
+// or JSON name. Unknown keys are returned as is, for the mask to reject.
+func fieldPath(desc protoreflect.MessageDescriptor, key string) string {
+	if fd := desc.Fields().ByName(protoreflect.Name(key)); fd != nil {
+		return key
+	}
+	if fd := desc.Fields().ByJSONName(key); fd != nil {
+		return string(fd.Name())
+	}
+	return key
+}
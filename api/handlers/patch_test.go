@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// patchContext returns a gin context for a PATCH of body with the query.
+func patchContext(body, query string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPatch, "/v1/wearable-data/w1?"+query, strings.NewReader(body))
+	return c
+}
+
+func TestBindPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		query    string
+		wantMask string
+		want     *health.WearableData
+		wantErr  string
+	}{
+		{
+			name:     "merge patch sets the fields present",
+			body:     `{"device_type":"smartwatch","recorded_timestamp":"2026-10-01T10:00:00Z"}`,
+			wantMask: "device_type,recorded_timestamp",
+			want:     &health.WearableData{Id: "w1", DeviceType: "smartwatch", RecordedTimestamp: "2026-10-01T10:00:00Z"},
+		},
+		{
+			name:     "null clears a field",
+			body:     `{"device_type":null}`,
+			wantMask: "device_type",
+			want:     &health.WearableData{Id: "w1"},
+		},
+		{
+			name:     "matching id is accepted",
+			body:     `{"id":"w1","device_type":"smartwatch"}`,
+			wantMask: "device_type",
+			want:     &health.WearableData{Id: "w1", DeviceType: "smartwatch"},
+		},
+		{
+			name:     "update mask keeps only the masked fields",
+			body:     `{"device_type":"smartwatch","user_id":"u2"}`,
+			query:    "update_mask=device_type",
+			wantMask: "device_type",
+			want:     &health.WearableData{Id: "w1", DeviceType: "smartwatch"},
+		},
+		{
+			name:     "update mask clears fields absent from the body",
+			body:     `{"device_type":"smartwatch"}`,
+			query:    "update_mask=device_type,%20recorded_timestamp",
+			wantMask: "device_type,recorded_timestamp",
+			want:     &health.WearableData{Id: "w1", DeviceType: "smartwatch"},
+		},
+		{
+			name:     "json names are accepted",
+			body:     `{"deviceType":"smartwatch"}`,
+			query:    "update_mask=device_type",
+			wantMask: "device_type",
+			want:     &health.WearableData{Id: "w1", DeviceType: "smartwatch"},
+		},
+		{
+			name:     "merge patch of json names",
+			body:     `{"deviceType":"smartwatch","recordedTimestamp":null}`,
+			wantMask: "device_type,recorded_timestamp",
+			want:     &health.WearableData{Id: "w1", DeviceType: "smartwatch"},
+		},
+		{
+			name:     "update mask of json names",
+			body:     `{"deviceType":"smartwatch"}`,
+			query:    "update_mask=deviceType",
+			wantMask: "device_type",
+			want:     &health.WearableData{Id: "w1", DeviceType: "smartwatch"},
+		},
+		{name: "body is not an object", body: `[1]`, wantErr: "body must be a JSON object"},
+		{name: "id mismatch", body: `{"id":"w2","user_id":"u2"}`, wantErr: "ID mismatch"},
+		{name: "no fields", body: `{}`, wantErr: "no fields to update"},
+		{name: "only the id", body: `{"id":"w1"}`, wantErr: "no fields to update"},
+		{name: "immutable field", body: `{"created_at":"2026-10-01T10:00:00Z"}`, wantErr: `field "created_at" cannot be updated`},
+		{name: "owner", body: `{"user_id":"u2"}`, wantErr: `field "user_id" cannot be updated`},
+		{name: "owner by json name", body: `{"userId":"u2"}`, wantErr: `field "user_id" cannot be updated`},
+		{name: "nested field", body: `{"device_type":"smartwatch"}`, query: "update_mask=data_value.heart_rate", wantErr: `nested field "data_value.heart_rate" cannot be updated`},
+		{name: "unknown field", body: `{"colour":"red"}`, wantErr: "invalid update mask"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var wearableData health.WearableData
+			mask, err := bindPatch(patchContext(tt.body, tt.query), "w1", &wearableData)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("bindPatch() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("bindPatch() error = %v", err)
+			}
+			if got := strings.Join(mask.GetPaths(), ","); got != tt.wantMask {
+				t.Errorf("bindPatch() mask = %q, want %q", got, tt.wantMask)
+			}
+			if !proto.Equal(&wearableData, tt.want) {
+				t.Errorf("bindPatch() = %v, want %v", &wearableData, tt.want)
+			}
+		})
+	}
+}
+
+func TestBindPatchDataValue(t *testing.T) {
+	for _, body := range []string{
+		`{"data_type":"heart_rate","data_value":{"heart_rate":72}}`,
+		`{"dataType":"heart_rate","dataValue":{"heart_rate":72}}`,
+	} {
+		var wearableData health.WearableData
+		mask, err := bindPatch(patchContext(body, ""), "w1", &wearableData)
+		if err != nil {
+			t.Fatalf("bindPatch(%s) error = %v", body, err)
+		}
+		if got := strings.Join(mask.GetPaths(), ","); got != "data_type,data_value" {
+			t.Errorf("bindPatch(%s) mask = %q, want %q", body, got, "data_type,data_value")
+		}
+
+		var heartRate health.HeartRateData
+		if err := wearableData.DataValue.UnmarshalTo(&heartRate); err != nil {
+			t.Fatalf("bindPatch(%s) data_value is not a HeartRateData: %v", body, err)
+		}
+		if heartRate.HeartRate != 72 {
+			t.Errorf("bindPatch(%s) heart_rate = %d, want 72", body, heartRate.HeartRate)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Dependency check states reported by the readiness probe.
+const (
+	probeStatusUp   = "up"
+	probeStatusDown = "down"
+)
+
+// ProbeHandler serves the liveness and readiness endpoints of the gateway.
+type ProbeHandler struct {
+	kafkaProducer   *kafka.Producer
+	healthGrpcConn  *grpc.ClientConn
+	healthChecker   healthpb.HealthClient
+	healthCheckName string
+	timeout         time.Duration
+	shuttingDown    atomic.Bool
+}
+
+// DependencyStatus describes the state of a single dependency.
+type DependencyStatus struct {
+	Status  string                 `json:"status"`
+	Error   string                 `json:"error,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// ReadinessResponse is the body returned by the readiness probe.
+type ReadinessResponse struct {
+	Status string                      `json:"status"`
+	Checks map[string]DependencyStatus `json:"checks"`
+}
+
+// NewProbeHandler creates a new ProbeHandler.
+func NewProbeHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn) *ProbeHandler {
+	return &ProbeHandler{
+		kafkaProducer:   kafkaProducer,
+		healthGrpcConn:  healthGrpcConn,
+		healthChecker:   healthpb.NewHealthClient(healthGrpcConn),
+		healthCheckName: kafkaProducer.Cfg.HealthSvcHealthCheckName,
+		timeout:         time.Duration(kafkaProducer.Cfg.ReadinessTimeout) * time.Second,
+	}
+}
+
+// MarkShuttingDown makes the readiness probe report not-ready so that load
+// balancers stop routing new traffic to this instance.
+func (h *ProbeHandler) MarkShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Liveness godoc
+// @Summary     Liveness probe
+// @Description Reports whether the gateway process is alive.
+// @Tags        Probes
+// @Produce     json
+// @Success     200     {object} map[string]interface{}
+// @Router      /healthz [get]
+func (h *ProbeHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": probeStatusUp})
+}
+
+// Readiness godoc
+// @Summary     Readiness probe
+// @Description Reports whether the gateway can serve traffic, with a breakdown per dependency.
+// @Tags        Probes
+// @Produce     json
+// @Success     200     {object} handlers.ReadinessResponse
+// @Failure     503     {object} handlers.ReadinessResponse
+// @Router      /readyz [get]
+func (h *ProbeHandler) Readiness(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		checks = make(map[string]DependencyStatus)
+	)
+	run := func(name string, check func(context.Context) DependencyStatus) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := check(ctx)
+			mu.Lock()
+			checks[name] = result
+			mu.Unlock()
+		}()
+	}
+
+	run("health_service", h.checkHealthService)
+	run("kafka", h.checkKafka)
+	wg.Wait()
+
+	ready := true
+	for _, check := range checks {
+		if check.Status != probeStatusUp {
+			ready = false
+		}
+	}
+	if h.shuttingDown.Load() {
+		ready = false
+		checks["gateway"] = DependencyStatus{Status: probeStatusDown, Error: "shutting down"}
+	}
+
+	response := ReadinessResponse{Status: "ready", Checks: checks}
+	if !ready {
+		response.Status = "not_ready"
+		c.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// checkHealthService inspects the connection state and probes the health
+// service through the grpc.health.v1 protocol.
+func (h *ProbeHandler) checkHealthService(ctx context.Context) DependencyStatus {
+	state := h.healthGrpcConn.GetState()
+	if state == connectivity.Idle {
+		h.healthGrpcConn.Connect()
+	}
+	details := map[string]interface{}{"connection_state": state.String()}
+
+	resp, err := h.healthChecker.Check(ctx, &healthpb.HealthCheckRequest{Service: h.healthCheckName})
+	if err != nil {
+		// A backend without the health service is reachable, which is all we can verify.
+		if status.Code(err) == codes.Unimplemented {
+			details["serving_status"] = codes.Unimplemented.String()
+			return DependencyStatus{Status: probeStatusUp, Details: details}
+		}
+		return DependencyStatus{Status: probeStatusDown, Error: err.Error(), Details: details}
+	}
+
+	details["serving_status"] = resp.GetStatus().String()
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return DependencyStatus{Status: probeStatusDown, Error: "health service is not serving", Details: details}
+	}
+
+	return DependencyStatus{Status: probeStatusUp, Details: details}
+}
+
+// checkKafka verifies broker reachability and metadata of the configured topics.
+func (h *ProbeHandler) checkKafka(ctx context.Context) DependencyStatus {
+	partitions, err := h.kafkaProducer.CheckTopics(ctx, h.kafkaProducer.Cfg.KafkaTopics()...)
+
+	details := map[string]interface{}{"brokers": h.kafkaProducer.Cfg.KafkaBrokers}
+	if partitions != nil {
+		details["topic_partitions"] = partitions
+	}
+	if err != nil {
+		return DependencyStatus{Status: probeStatusDown, Error: err.Error(), Details: details}
+	}
+
+	return DependencyStatus{Status: probeStatusUp, Details: details}
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/recommend"
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
+)
+
+// RecommendationRulesResponse is the body listing the recommendation rules.
+type RecommendationRulesResponse struct {
+	Version  string           `json:"version"`
+	LoadedAt time.Time        `json:"loaded_at"`
+	Rules    []recommend.Rule `json:"rules"`
+}
+
+// RecommendationEvaluationResponse is the body of a recommendation rules
+// evaluation.
+type RecommendationEvaluationResponse struct {
+	UserID       string                 `json:"user_id"`
+	Period       string                 `json:"period"`
+	StartDate    string                 `json:"start_date"`
+	EndDate      string                 `json:"end_date"`
+	RulesVersion string                 `json:"rules_version"`
+	Evaluations  []recommend.Evaluation `json:"evaluations"`
+	// Published is the number of recommendations published, only set when
+	// the recommendations are generated.
+	Published *int `json:"published,omitempty"`
+	// Error reports why publishing stopped, after Published recommendations.
+	Error     string `json:"error,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// RecommendationEngineHandler generates health recommendations from the
+// summaries of a user.
+type RecommendationEngineHandler struct {
+	kafkaProducer *kafka.Producer
+	engine        *recommend.Engine
+	monitoring    *HealthMonitoringHandler
+}
+
+// NewRecommendationEngineHandler creates a new RecommendationEngineHandler.
+// engine may be nil when the recommendation engine is disabled, in which case
+// its routes are unavailable.
+func NewRecommendationEngineHandler(kafkaProducer *kafka.Producer, engine *recommend.Engine, monitoring *HealthMonitoringHandler) *RecommendationEngineHandler {
+	return &RecommendationEngineHandler{
+		kafkaProducer: kafkaProducer,
+		engine:        engine,
+		monitoring:    monitoring,
+	}
+}
+
+// ListRecommendationRules godoc
+// @Summary     List recommendation rules
+// @Description List the version of the recommendation rules currently loaded and their rules.
+// @Tags        RecommendationRules
+// @Accept      json
+// @Produce     json
+// @Security    ApiKeyAuth
+// @Success     200     {object} handlers.RecommendationRulesResponse
+// @Failure     503     {object} map[string]interface{}
+// @Router      /v1/recommendation-rules [get]
+func (h *RecommendationEngineHandler) ListRecommendationRules(c *gin.Context) {
+	if h.engine == nil {
+		c.JSON(http.StatusServiceUnavailable, response.ErrorBody(c, "Recommendation engine is disabled"))
+		return
+	}
+
+	rules, loadedAt := h.engine.Rules()
+	c.JSON(http.StatusOK, RecommendationRulesResponse{
+		Version:  rules.Version,
+		LoadedAt: loadedAt,
+		Rules:    rules.Rules,
+	})
+}
+
+// EvaluateRecommendationRules godoc
+// @Summary     Dry-run recommendation rules
+// @Description Evaluate the recommendation rules of a period against the daily or weekly summary of a user, without publishing any recommendation.
+// @Tags        RecommendationRules
+// @Accept      json
+// @Produce     json
+// @Param       user_id    path     string true  "User ID"
+// @Param       period     query    string true  "Summary period (daily or weekly)"
+// @Param       date       query    string false "Date of a daily summary (YYYY-MM-DD)"
+// @Param       start_date query    string false "Start Date of a weekly summary (YYYY-MM-DD)"
+// @Param       end_date   query    string false "End Date of a weekly summary (YYYY-MM-DD)"
+// @Security    ApiKeyAuth
+// @Success     200     {object} handlers.RecommendationEvaluationResponse
+// @Failure     400     {object} map[string]interface{}
+// @Failure     403     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Failure     503     {object} map[string]interface{}
+// @Router      /v1/recommendation-rules/evaluate/{user_id} [get]
+func (h *RecommendationEngineHandler) EvaluateRecommendationRules(c *gin.Context) {
+	evaluation, ok := h.evaluate(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, evaluation)
+}
+
+// GenerateRecommendations godoc
+// @Summary     Generate recommendations
+// @Description Evaluate the recommendation rules of a period against the daily or weekly summary of a user and publish the recommendation of every rule that fires. Admins and doctors only. A recommendation keeps the same ID across runs of the same rules version, so a request that failed after publishing some of them, reported with a 500 carrying the evaluation and the number published, can be retried.
+// @Tags        RecommendationRules
+// @Accept      json
+// @Produce     json
+// @Param       user_id    path     string true  "User ID"
+// @Param       period     query    string true  "Summary period (daily or weekly)"
+// @Param       date       query    string false "Date of a daily summary (YYYY-MM-DD)"
+// @Param       start_date query    string false "Start Date of a weekly summary (YYYY-MM-DD)"
+// @Param       end_date   query    string false "End Date of a weekly summary (YYYY-MM-DD)"
+// @Security    ApiKeyAuth
+// @Success     202     {object} handlers.RecommendationEvaluationResponse
+// @Failure     400     {object} map[string]interface{}
+// @Failure     403     {object} map[string]interface{}
+// @Failure     500     {object} handlers.RecommendationEvaluationResponse
+// @Failure     503     {object} map[string]interface{}
+// @Router      /v1/recommendation-rules/evaluate/{user_id} [post]
+func (h *RecommendationEngineHandler) GenerateRecommendations(c *gin.Context) {
+	evaluation, ok := h.evaluate(c)
+	if !ok {
+		return
+	}
+
+	// Publish to Kafka
+	published := 0
+	for _, e := range evaluation.Evaluations {
+		if e.Recommendation == nil {
+			continue
+		}
+		if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaHealthRecommendationTopic, "health_recommendation.create", e.Recommendation); err != nil {
+			evaluation.Published = &published
+			evaluation.Error = "Failed to create health recommendation " + err.Error()
+			evaluation.RequestID = c.GetString(requestid.ContextKey)
+			c.JSON(http.StatusInternalServerError, evaluation)
+			return
+		}
+		published++
+	}
+	evaluation.Published = &published
+
+	c.JSON(http.StatusAccepted, evaluation)
+}
+
+// evaluate evaluates the rules against the summary requested, writing the
+// error response when it fails.
+func (h *RecommendationEngineHandler) evaluate(c *gin.Context) (*RecommendationEvaluationResponse, bool) {
+	if h.engine == nil {
+		c.JSON(http.StatusServiceUnavailable, response.ErrorBody(c, "Recommendation engine is disabled"))
+		return nil, false
+	}
+
+	userID := c.Param("user_id")
+	period, err := parseRecommendationPeriod(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid period "+err.Error()))
+		return nil, false
+	}
+
+	var grpcResponse *health.SummaryResponse
+	if c.Query("period") == recommend.PeriodDaily {
+		grpcResponse, err = h.monitoring.dailySummary(c.Request.Context(), userID, period.Start)
+	} else {
+		grpcResponse, err = h.monitoring.weeklySummary(c.Request.Context(), userID, period)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get summary "+err.Error()))
+		return nil, false
+	}
+
+	evaluations, version := h.engine.Evaluate(userID, c.Query("period"), period.Start, period.End, summary.Statistics(grpcResponse))
+	return &RecommendationEvaluationResponse{
+		UserID:       userID,
+		Period:       c.Query("period"),
+		StartDate:    period.Start.Format(dateLayout),
+		EndDate:      period.End.Format(dateLayout),
+		RulesVersion: version,
+		Evaluations:  evaluations,
+	}, true
+}
+
+// parseRecommendationPeriod reads the summary period of the query: a date for
+// a daily summary, or a start_date and end_date for a weekly one.
+func parseRecommendationPeriod(c *gin.Context) (summary.Period, error) {
+	switch c.Query("period") {
+	case recommend.PeriodDaily:
+		date, err := parseDateParam(c, "date")
+		if err != nil {
+			return summary.Period{}, err
+		}
+		return summary.Period{Start: date, End: date}, nil
+	case recommend.PeriodWeekly:
+		return parseSummaryPeriod(c, summary.WeekDays)
+	default:
+		return summary.Period{}, fmt.Errorf("period must be daily or weekly")
+	}
+}
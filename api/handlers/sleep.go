@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// sleepDataType is the lifestyle data type of sleep records.
+const sleepDataType = "sleep"
+
+// SleepHandler handles requests related to Sleep Data, stored as lifestyle
+// data of type "sleep".
+type SleepHandler struct {
+	kafkaProducer *kafka.Producer
+	service       health.LifestyleDataServiceClient
+	renderer      *response.Renderer
+}
+
+// NewSleepHandler creates a new SleepHandler.
+func NewSleepHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer) *SleepHandler {
+	return &SleepHandler{
+		kafkaProducer: kafkaProducer,
+		service:       health.NewLifestyleDataServiceClient(healthGrpcConn),
+		renderer:      renderer,
+	}
+}
+
+// CreateSleepData godoc
+// @Summary     Record Sleep Data
+// @Description Record a night of sleep. It is stored as lifestyle data of type "sleep".
+// @Tags        Sleep
+// @Accept      json
+// @Produce     json
+// @Param       sleepData body     health.SleepData true "Sleep Data details"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/sleep [post]
+func (h *SleepHandler) CreateSleepData(c *gin.Context) {
+	var sleepData health.SleepData
+	if err := bindEntity(c, &sleepData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.SleepData(&sleepData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, sleepData.UserId)
+
+	dataValue, err := anypb.New(&sleepData)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create sleep data "+err.Error()))
+		return
+	}
+	lifestyleData := health.LifestyleData{
+		UserId:       sleepData.UserId,
+		DataType:     sleepDataType,
+		DataValue:    dataValue,
+		RecordedDate: sleepData.RecordedDate,
+	}
+
+	// Publish to Kafka
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaLifestyleDataTopic, "lifestyle_data.create", &lifestyleData); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create sleep data "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Sleep data creation request accepted"})
+}
+
+// sleepSortFields are the fields ListSleepData can be sorted by.
+var sleepSortFields = []string{"recorded_date", "created_at", "updated_at"}
+
+// ListSleepData godoc
+// @Summary     List Sleep Data
+// @Description Get the sleep series of a user.
+// @Tags        Sleep
+// @Accept      json
+// @Produce     json
+// @Param        user_id    query    string true   "User ID"
+// @Param        from       query    string false  "Earliest recorded date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        to         query    string false  "Latest recorded date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -recorded_date)"
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.SleepSeries
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/sleep [get]
+func (h *SleepHandler) ListSleepData(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "user_id is required"))
+		return
+	}
+	audit.SetPatient(c, userID)
+
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, sleepSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
+	recordedDateRange, err := parseDateRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid time range "+err.Error()))
+		return
+	}
+
+	// Use gRPC to get the sleep records from the lifestyle data service, until
+	// the page is full of records holding a night of sleep
+	req := &health.ListLifestyleDataRequest{
+		UserId:           userID,
+		DataType:         sleepDataType,
+		RecordedDateFrom: recordedDateRange.From,
+		RecordedDateTo:   recordedDateRange.To,
+		PageToken:        page.PageToken,
+		OrderBy:          page.OrderBy,
+	}
+	series := &health.SleepSeries{}
+	for fetches := 0; fetches < maxTypedPageFetches; fetches++ {
+		req.PageSize = page.PageSize - int32(len(series.SleepData))
+		grpcResponse, err := h.service.ListLifestyleData(c.Request.Context(), req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get sleep data "+err.Error()))
+			return
+		}
+
+		for _, lifestyleData := range grpcResponse.LifestyleData {
+			var sleepData health.SleepData
+			// Skip records whose value is not a night of sleep
+			if err := datavalue.Unpack(lifestyleData.DataValue, &sleepData); err != nil || sleepData.SleepDuration == 0 {
+				continue
+			}
+			sleepData.UserId = lifestyleData.UserId
+			sleepData.RecordedDate = lifestyleData.RecordedDate
+			series.SleepData = append(series.SleepData, &sleepData)
+		}
+
+		series.NextPageToken = grpcResponse.NextPageToken
+		req.PageToken = grpcResponse.NextPageToken
+		if req.PageToken == "" || int32(len(series.SleepData)) >= page.PageSize {
+			break
+		}
+	}
+
+	setPageLinks(c, series.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, series)
+}
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+)
+
+// fakeLifestyleDataService serves ListLifestyleData from pages keyed by page
+// token and records the requests it gets.
+type fakeLifestyleDataService struct {
+	health.LifestyleDataServiceClient
+	pages    map[string]*health.ListLifestyleDataResponse
+	err      error
+	requests []*health.ListLifestyleDataRequest
+}
+
+func (s *fakeLifestyleDataService) ListLifestyleData(_ context.Context, in *health.ListLifestyleDataRequest, _ ...grpc.CallOption) (*health.ListLifestyleDataResponse, error) {
+	s.requests = append(s.requests, proto.Clone(in).(*health.ListLifestyleDataRequest))
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.pages[in.PageToken], nil
+}
+
+// vitalsConfig is the configuration of the typed vitals handlers under test.
+var vitalsConfig = config.Config{ListDefaultPageSize: 50, ListMaxPageSize: 500, ResponseUseProtoNames: true}
+
+// packValue packs m as a data_value.
+func packValue(t *testing.T, m proto.Message) *anypb.Any {
+	t.Helper()
+	value, err := anypb.New(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func TestListSleepData(t *testing.T) {
+	night := func(duration int64, quality string) *health.SleepData {
+		return &health.SleepData{SleepDuration: duration, SleepQuality: quality}
+	}
+	legacy, err := structpb.NewStruct(map[string]interface{}{"sleep_duration": 25200000, "sleep_quality": "Poor"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stress, err := structpb.NewStruct(map[string]interface{}{"value": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pages := map[string]*health.ListLifestyleDataResponse{
+		"": {
+			LifestyleData: []*health.LifestyleData{
+				{UserId: "u1", RecordedDate: "2026-10-01", DataValue: packValue(t, night(28800000, "Good"))},
+				{UserId: "u1", RecordedDate: "2026-10-02", DataValue: packValue(t, stress)},
+			},
+			NextPageToken: "p2",
+		},
+		"p2": {
+			LifestyleData: []*health.LifestyleData{
+				{UserId: "u1", RecordedDate: "2026-10-03"},
+				{UserId: "u1", RecordedDate: "2026-10-04", DataValue: packValue(t, legacy)},
+				{UserId: "u1", RecordedDate: "2026-10-05", DataValue: packValue(t, night(27000000, "Average"))},
+			},
+			NextPageToken: "p3",
+		},
+		"p3": {
+			LifestyleData: []*health.LifestyleData{
+				{UserId: "u1", RecordedDate: "2026-10-06", DataValue: packValue(t, night(30000000, "Good"))},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		query        string
+		err          error
+		wantStatus   int
+		wantError    string
+		wantDates    []string
+		wantNext     string
+		wantRequests []*health.ListLifestyleDataRequest
+	}{
+		{name: "no user", query: "from=2026-10-01", wantStatus: http.StatusBadRequest, wantError: "user_id is required"},
+		{name: "bad limit", query: "user_id=u1&limit=0", wantStatus: http.StatusBadRequest, wantError: "Invalid pagination parameters limit must be a positive integer"},
+		{name: "bad sort", query: "user_id=u1&sort=sleep_quality", wantStatus: http.StatusBadRequest,
+			wantError: `Invalid pagination parameters cannot sort by "sleep_quality", expected one of recorded_date, created_at, updated_at`},
+		{name: "bad range", query: "user_id=u1&from=2026-10-02&to=2026-10-01", wantStatus: http.StatusBadRequest, wantError: "Invalid time range from must not be after to"},
+		{name: "service error", query: "user_id=u1", err: errors.New("unavailable"), wantStatus: http.StatusInternalServerError,
+			wantError: "Failed to get sleep data unavailable",
+			wantRequests: []*health.ListLifestyleDataRequest{
+				{UserId: "u1", DataType: sleepDataType, PageSize: 50},
+			}},
+		{name: "every night", query: "user_id=u1&from=2026-10-01&to=2026-10-31T12:00:00Z&sort=-recorded_date", wantStatus: http.StatusOK,
+			wantDates: []string{"2026-10-01", "2026-10-04", "2026-10-05", "2026-10-06"},
+			wantRequests: []*health.ListLifestyleDataRequest{
+				{UserId: "u1", DataType: sleepDataType, RecordedDateFrom: "2026-10-01", RecordedDateTo: "2026-10-31", OrderBy: "recorded_date desc", PageSize: 50},
+				{UserId: "u1", DataType: sleepDataType, RecordedDateFrom: "2026-10-01", RecordedDateTo: "2026-10-31", OrderBy: "recorded_date desc", PageSize: 49, PageToken: "p2"},
+				{UserId: "u1", DataType: sleepDataType, RecordedDateFrom: "2026-10-01", RecordedDateTo: "2026-10-31", OrderBy: "recorded_date desc", PageSize: 47, PageToken: "p3"},
+			}},
+		{name: "page filled across service pages", query: "user_id=u1&limit=3", wantStatus: http.StatusOK,
+			wantDates: []string{"2026-10-01", "2026-10-04", "2026-10-05"},
+			wantNext:  "p3",
+			wantRequests: []*health.ListLifestyleDataRequest{
+				{UserId: "u1", DataType: sleepDataType, PageSize: 3},
+				{UserId: "u1", DataType: sleepDataType, PageSize: 2, PageToken: "p2"},
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &fakeLifestyleDataService{pages: pages, err: tt.err}
+			h := &SleepHandler{kafkaProducer: &kafka.Producer{Cfg: vitalsConfig}, service: service, renderer: response.NewRenderer(vitalsConfig)}
+			c, rec := listContext("/v1/sleep?" + tt.query)
+			h.ListSleepData(c)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantError != "" && !strings.Contains(rec.Body.String(), `"error":"`+strings.ReplaceAll(tt.wantError, `"`, `\"`)+`"`) {
+				t.Errorf("body = %s, want error %q", rec.Body, tt.wantError)
+			}
+			if len(service.requests) != len(tt.wantRequests) {
+				t.Fatalf("service got %d requests, want %d", len(service.requests), len(tt.wantRequests))
+			}
+			for i, req := range service.requests {
+				if !proto.Equal(req, tt.wantRequests[i]) {
+					t.Errorf("request %d = %v, want %v", i, req, tt.wantRequests[i])
+				}
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var series health.SleepSeries
+			if err := protojson.Unmarshal(rec.Body.Bytes(), &series); err != nil {
+				t.Fatalf("decode %s: %v", rec.Body, err)
+			}
+			var dates []string
+			for _, s := range series.SleepData {
+				if s.UserId != "u1" || s.SleepDuration == 0 {
+					t.Errorf("sleep data = %v, want the user and duration set", s)
+				}
+				dates = append(dates, s.RecordedDate)
+			}
+			if strings.Join(dates, ",") != strings.Join(tt.wantDates, ",") {
+				t.Errorf("recorded dates = %v, want %v", dates, tt.wantDates)
+			}
+			if series.NextPageToken != tt.wantNext {
+				t.Errorf("next_page_token = %q, want %q", series.NextPageToken, tt.wantNext)
+			}
+			if link := rec.Header().Get("Link"); (tt.wantNext != "") != strings.Contains(link, "page_token="+tt.wantNext) {
+				t.Errorf("Link = %q, want a next link to %q", link, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestListSleepDataFetchLimit(t *testing.T) {
+	stress, err := structpb.NewStruct(map[string]interface{}{"value": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every service page holds no sleep and points to the next one
+	pages := map[string]*health.ListLifestyleDataResponse{}
+	token := ""
+	for i := 0; i <= maxTypedPageFetches; i++ {
+		next := fmt.Sprintf("p%d", i+1)
+		pages[token] = &health.ListLifestyleDataResponse{
+			LifestyleData: []*health.LifestyleData{{UserId: "u1", DataValue: packValue(t, stress)}},
+			NextPageToken: next,
+		}
+		token = next
+	}
+
+	service := &fakeLifestyleDataService{pages: pages}
+	h := &SleepHandler{kafkaProducer: &kafka.Producer{Cfg: vitalsConfig}, service: service, renderer: response.NewRenderer(vitalsConfig)}
+	c, rec := listContext("/v1/sleep?user_id=u1")
+	h.ListSleepData(c)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if len(service.requests) != maxTypedPageFetches {
+		t.Errorf("service got %d requests, want %d", len(service.requests), maxTypedPageFetches)
+	}
+	var series health.SleepSeries
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &series); err != nil {
+		t.Fatalf("decode %s: %v", rec.Body, err)
+	}
+	if wantNext := fmt.Sprintf("p%d", maxTypedPageFetches); len(series.SleepData) != 0 || series.NextPageToken != wantNext {
+		t.Errorf("series = %v, want an empty page resuming from %s", &series, wantNext)
+	}
+}
+
+func TestCreateSleepDataRejectsInvalidBodies(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantError string
+	}{
+		{name: "not json", body: `{`, wantError: "Invalid request body"},
+		{name: "wrong type", body: `{"sleep_duration":"long"}`, wantError: "Invalid request body"},
+		{name: "invalid fields", body: `{"user_id":"u1","sleep_duration":-1,"sleep_quality":"great","recorded_date":"2026-10-01"}`,
+			wantError: `"fields":[{"field":"sleep_duration"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/sleep", strings.NewReader(tt.body))
+
+			// A nil Kafka writer fails the test if the handler publishes
+			h := &SleepHandler{kafkaProducer: &kafka.Producer{Cfg: vitalsConfig}, renderer: response.NewRenderer(vitalsConfig)}
+			h.CreateSleepData(c)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantError) {
+				t.Errorf("body = %s, want it to contain %s", rec.Body, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// dateLayout is the format of the date fields of the health entities.
+const dateLayout = "2006-01-02"
+
+// timeRange is an inclusive range read from the "from" and "to" query
+// parameters of a List request. Either bound may be empty.
+type timeRange struct {
+	From string
+	To   string
+}
+
+// parseDateRange reads a range over a YYYY-MM-DD field. Bounds may be given as
+// dates or RFC 3339 timestamps, which are reduced to their UTC date.
+func parseDateRange(c *gin.Context) (timeRange, error) {
+	from, to, err := parseRangeBounds(c)
+	if err != nil {
+		return timeRange{}, err
+	}
+	return timeRange{From: formatBound(from, dateLayout), To: formatBound(to, dateLayout)}, nil
+}
+
+// parseTimestampRange reads a range over an RFC 3339 field. A date given as the
+// upper bound covers that whole day.
+func parseTimestampRange(c *gin.Context) (timeRange, error) {
+	from, to, err := parseRangeBounds(c)
+	if err != nil {
+		return timeRange{}, err
+	}
+	return timeRange{From: formatBound(from, time.RFC3339Nano), To: formatBound(to, time.RFC3339Nano)}, nil
+}
+
+func parseRangeBounds(c *gin.Context) (from, to time.Time, err error) {
+	return parseRangeBoundsIn(c, time.UTC)
+}
+
+// parseRangeBoundsIn reads the "from" and "to" query parameters, taking dates
+// as days in loc.
+func parseRangeBoundsIn(c *gin.Context, loc *time.Location) (from, to time.Time, err error) {
+	if from, err = parseBound(c.Query("from"), false, loc); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("from: %w", err)
+	}
+	if to, err = parseBound(c.Query("to"), true, loc); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("to: %w", err)
+	}
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must not be after to")
+	}
+	return from, to, nil
+}
+
+// parseBound parses an RFC 3339 timestamp or a date in loc. Dates are taken as
+// the start of the day, or its last instant when endOfDay is set.
+func parseBound(value string, endOfDay bool, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t.UTC(), nil
+	}
+
+	t, err := time.ParseInLocation(dateLayout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither an RFC 3339 timestamp nor a YYYY-MM-DD date", value)
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return t, nil
+}
+
+func formatBound(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layout)
+}
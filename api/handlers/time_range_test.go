@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    timeRange
+		wantErr string
+	}{
+		{name: "no bounds"},
+		{name: "dates", query: "from=2026-10-01&to=2026-10-31", want: timeRange{From: "2026-10-01", To: "2026-10-31"}},
+		{name: "from only", query: "from=2026-10-01", want: timeRange{From: "2026-10-01"}},
+		{name: "same day", query: "from=2026-10-01&to=2026-10-01", want: timeRange{From: "2026-10-01", To: "2026-10-01"}},
+		{name: "timestamps reduced to utc dates", query: "from=2026-10-01T23:30:00-02:00&to=2026-10-31T01:00:00%2B02:00",
+			want: timeRange{From: "2026-10-02", To: "2026-10-30"}},
+		{name: "bad from", query: "from=yesterday", wantErr: `from: "yesterday" is neither an RFC 3339 timestamp nor a YYYY-MM-DD date`},
+		{name: "bad to", query: "to=2026-13-01", wantErr: `to: "2026-13-01" is neither an RFC 3339 timestamp nor a YYYY-MM-DD date`},
+		{name: "reversed", query: "from=2026-10-31&to=2026-10-01", wantErr: "from must not be after to"},
+	}
+
+	for _, tt := range tests {
+		c, _ := listContext("/v1/medical-records?" + tt.query)
+		got, err := parseDateRange(c)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("%s: parseDateRange() error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: parseDateRange() error = %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: parseDateRange() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimestampRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    timeRange
+		wantErr string
+	}{
+		{name: "no bounds"},
+		{name: "dates cover whole days", query: "from=2026-10-01&to=2026-10-01",
+			want: timeRange{From: "2026-10-01T00:00:00Z", To: "2026-10-01T23:59:59.999999999Z"}},
+		{name: "timestamps in utc", query: "from=2026-10-01T08:00:00%2B02:00&to=2026-10-01T12:00:00.5Z",
+			want: timeRange{From: "2026-10-01T06:00:00Z", To: "2026-10-01T12:00:00.5Z"}},
+		{name: "to only", query: "to=2026-10-01T12:00:00Z", want: timeRange{To: "2026-10-01T12:00:00Z"}},
+		{name: "reversed instants", query: "from=2026-10-01T12:00:01Z&to=2026-10-01T12:00:00Z", wantErr: "from must not be after to"},
+		{name: "timestamp within the to date", query: "from=2026-10-01T23:00:00Z&to=2026-10-01",
+			want: timeRange{From: "2026-10-01T23:00:00Z", To: "2026-10-01T23:59:59.999999999Z"}},
+	}
+
+	for _, tt := range tests {
+		c, _ := listContext("/v1/wearable-data?" + tt.query)
+		got, err := parseTimestampRange(c)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("%s: parseTimestampRange() error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: parseTimestampRange() error = %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: parseTimestampRange() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseRangeBoundsIn(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	c, _ := listContext("/v1/wearable-data?from=2026-10-01&to=2026-10-01T12:00:00Z")
+
+	from, to, err := parseRangeBoundsIn(c, loc)
+	if err != nil {
+		t.Fatalf("parseRangeBoundsIn() error = %v", err)
+	}
+	if want := time.Date(2026, 9, 30, 22, 0, 0, 0, time.UTC); !from.Equal(want) {
+		t.Errorf("from = %s, want the start of the day in %s, %s", from, loc, want)
+	}
+	if want := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC); !to.Equal(want) {
+		t.Errorf("to = %s, want %s", to, want)
+	}
+}
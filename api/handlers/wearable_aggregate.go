@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/timeseries"
+)
+
+// ndjsonContentType is the media type of streamed aggregates, one bucket per
+// line.
+const ndjsonContentType = "application/x-ndjson"
+
+// AggregateWearableData godoc
+// @Summary     Aggregate Wearable Data
+// @Description Roll up the numeric wearable data of a user into minute, hour or day buckets aligned to a time zone. Buckets without samples are omitted. With "Accept: application/x-ndjson" buckets are streamed one per line as they are computed.
+// @Tags        WearableData
+// @Accept      json
+// @Produce     json
+// @Produce     application/x-ndjson
+// @Param        user_id    query    string true   "User ID"
+// @Param        data_type  query    string true   "Data type, e.g. heart_rate, steps or oxygen_saturation"
+// @Param        device_type query   string false  "Filter by device type"
+// @Param        from       query    string true   "Earliest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD in tz)"
+// @Param        to         query    string true   "Latest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD in tz)"
+// @Param        bucket     query    string false  "Bucket size: minute, hour or day" default(hour)
+// @Param        functions  query    string false  "Comma separated functions: count, min, max, avg, pNN (e.g. p95)" default(count,min,max,avg)
+// @Param        tz         query    string false  "IANA time zone buckets are aligned to" default(UTC)
+// @Security    ApiKeyAuth
+// @Success     200     {object} health.WearableDataAggregate
+// @Failure     400     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/wearable-data/aggregate [get]
+func (h *WearableDataHandler) AggregateWearableData(c *gin.Context) {
+	userID := c.Query("user_id")
+	dataType := c.Query("data_type")
+	if userID == "" || dataType == "" {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "user_id and data_type are required"))
+		return
+	}
+	audit.SetPatient(c, userID)
+
+	size, err := timeseries.ParseBucketSize(c.DefaultQuery("bucket", string(timeseries.Hour)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid bucket "+err.Error()))
+		return
+	}
+
+	functions := timeseries.DefaultFunctions
+	if list := c.Query("functions"); list != "" {
+		if functions, err = timeseries.ParseFunctions(list); err != nil {
+			c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid functions "+err.Error()))
+			return
+		}
+	}
+
+	loc, err := time.LoadLocation(c.DefaultQuery("tz", "UTC"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid tz "+err.Error()))
+		return
+	}
+
+	from, to, err := parseRangeBoundsIn(c, loc)
+	if err == nil && (from.IsZero() || to.IsZero()) {
+		err = fmt.Errorf("from and to are required")
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid time range "+err.Error()))
+		return
+	}
+	if buckets := size.Count(from, to); buckets > int64(h.kafkaProducer.Cfg.AggregateMaxBuckets) {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, fmt.Sprintf("Invalid time range spans %d %s buckets, at most %d are allowed", buckets, size, h.kafkaProducer.Cfg.AggregateMaxBuckets)))
+		return
+	}
+
+	aggregate := &health.WearableDataAggregate{
+		UserId:   userID,
+		DataType: dataType,
+		Bucket:   string(size),
+		TimeZone: loc.String(),
+	}
+
+	// Buffer the buckets, or write them one per line
+	emit := func(bucket *health.WearableDataBucket) error {
+		aggregate.Buckets = append(aggregate.Buckets, bucket)
+		return nil
+	}
+	stream := strings.Contains(c.GetHeader("Accept"), ndjsonContentType)
+	if stream {
+		emit = func(bucket *health.WearableDataBucket) error {
+			line, err := h.renderer.Marshal(bucket)
+			if err != nil {
+				return err
+			}
+			if !c.Writer.Written() {
+				c.Header("Content-Type", ndjsonContentType)
+				c.Status(http.StatusOK)
+			}
+			if _, err := c.Writer.Write(append(line, '\n')); err != nil {
+				return err
+			}
+			c.Writer.Flush()
+			return nil
+		}
+	}
+
+	aggregator := timeseries.NewAggregator(size, loc, functions, emit)
+	err = h.aggregate(c, &health.ListWearableDataRequest{
+		UserId:                userID,
+		DeviceType:            c.Query("device_type"),
+		DataType:              dataType,
+		RecordedTimestampFrom: from.UTC().Format(time.RFC3339Nano),
+		RecordedTimestampTo:   to.UTC().Format(time.RFC3339Nano),
+		PageSize:              int32(h.kafkaProducer.Cfg.ListMaxPageSize),
+		OrderBy:               "recorded_timestamp",
+	}, aggregator)
+	if err != nil {
+		if stream && c.Writer.Written() {
+			// The status is already sent, report the failure as the last line
+			line, _ := json.Marshal(response.ErrorBody(c, "Failed to aggregate wearable data "+err.Error()))
+			c.Writer.Write(append(line, '\n'))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to aggregate wearable data "+err.Error()))
+		return
+	}
+
+	if !stream {
+		h.renderer.JSON(c, http.StatusOK, aggregate)
+	} else if !c.Writer.Written() {
+		c.Data(http.StatusOK, ndjsonContentType, nil)
+	}
+}
+
+// aggregate feeds every page of wearable data matching req to aggregator.
+// Samples without a numeric value are skipped.
+func (h *WearableDataHandler) aggregate(c *gin.Context, req *health.ListWearableDataRequest, aggregator *timeseries.Aggregator) error {
+	for {
+		grpcResponse, err := h.service.ListWearableData(c.Request.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		for _, wearableData := range grpcResponse.WearableData {
+			recorded, err := time.Parse(time.RFC3339Nano, wearableData.RecordedTimestamp)
+			if err != nil {
+				continue
+			}
+			value, ok := datavalue.Number(wearableData.DataValue)
+			if !ok {
+				continue
+			}
+			if err := aggregator.Add(recorded, value); err != nil {
+				return err
+			}
+		}
+
+		if grpcResponse.NextPageToken == "" {
+			return aggregator.Flush()
+		}
+		req.PageToken = grpcResponse.NextPageToken
+	}
+}
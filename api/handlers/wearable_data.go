@@ -5,25 +5,32 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/anomaly"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
 	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
 	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // WearableDataHandler handles requests related to Wearable Data.
 type WearableDataHandler struct {
 	kafkaProducer *kafka.Producer
 	service       health.WearableDataServiceClient
+	renderer      *response.Renderer
+	detector      *anomaly.Detector
 }
 
 // NewWearableDataHandler creates a new WearableDataHandler.
-func NewWearableDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn) *WearableDataHandler {
+func NewWearableDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.ClientConn, renderer *response.Renderer, detector *anomaly.Detector) *WearableDataHandler {
 	return &WearableDataHandler{
 		kafkaProducer: kafkaProducer,
 		service:       health.NewWearableDataServiceClient(healthGrpcConn),
+		renderer:      renderer,
+		detector:      detector,
 	}
 }
 
@@ -41,17 +48,25 @@ func NewWearableDataHandler(kafkaProducer *kafka.Producer, healthGrpcConn *grpc.
 // @Router      /v1/wearable-data [post]
 func (h *WearableDataHandler) CreateWearableData(c *gin.Context) {
 	var wearableData health.WearableData
-	if err := c.ShouldBindJSON(&wearableData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &wearableData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
 		return
 	}
+	if errs := validation.WearableData(&wearableData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+	audit.SetPatient(c, wearableData.UserId)
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaWearableDataTopic, "wearable_data.create", &wearableData); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create wearable data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to create wearable data "+err.Error()))
 		return
 	}
 
+	// Alert on anomalous samples
+	raiseAlerts(c, h.kafkaProducer, h.detector, &wearableData)
+
 	c.JSON(http.StatusAccepted, gin.H{"message": "Wearable data creation request accepted"})
 }
 
@@ -62,8 +77,10 @@ func (h *WearableDataHandler) CreateWearableData(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Wearable Data ID"
+// @Param       If-None-Match header string false "ETag of a cached copy, answered with 304 while unchanged"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.WearableData
+// @Header      200     {string} ETag "Version of the record"
 // @Failure     400     {object} map[string]interface{}
 // @Failure     404     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
@@ -72,40 +89,28 @@ func (h *WearableDataHandler) GetWearableData(c *gin.Context) {
 	wearableDataID := c.Param("id")
 
 	// Use gRPC to get the wearable data from the service
-	grpcResponse, err := h.service.GetWearableData(context.Background(), &health.ByIdRequest{Id: wearableDataID})
+	grpcResponse, err := h.service.GetWearableData(c.Request.Context(), &health.ByIdRequest{Id: wearableDataID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Wearable data not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Wearable data not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get wearable data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get wearable data "+err.Error()))
 		return
 	}
 
-	// Convert Any proto message to JSON
-	dataValueJSON, err := protojson.Marshal(grpcResponse.DataValue)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal data value " + err.Error()})
-		return
-	}
+	audit.SetPatient(c, grpcResponse.UserId)
 
-	// Create a response map with the converted data value
-	response := map[string]interface{}{
-		"id":                 grpcResponse.Id,
-		"user_id":            grpcResponse.UserId,
-		"device_type":        grpcResponse.DeviceType,
-		"data_type":          grpcResponse.DataType,
-		"data_value":         string(dataValueJSON),
-		"recorded_timestamp": grpcResponse.RecordedTimestamp,
-		"created_at":         grpcResponse.CreatedAt,
-		"updated_at":         grpcResponse.UpdatedAt,
+	// Answer conditional requests for an unchanged record
+	if notModified(c, grpcResponse) {
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
 
 // UpdateWearableData godoc
@@ -116,34 +121,95 @@ func (h *WearableDataHandler) GetWearableData(c *gin.Context) {
 // @Produce     json
 // @Param       id           path     string                   true "Wearable Data ID"
 // @Param       wearableData body     health.WearableData true "Updated wearable data"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     202     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/wearable-data/{id} [put]
 func (h *WearableDataHandler) UpdateWearableData(c *gin.Context) {
 	wearableDataID := c.Param("id")
 	var wearableData health.WearableData
-	if err := c.ShouldBindJSON(&wearableData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body " + err.Error()})
+	if err := bindEntity(c, &wearableData); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid request body "+err.Error()))
+		return
+	}
+	if errs := validation.WearableData(&wearableData); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
 		return
 	}
 
 	// Ensure the ID in the URL matches the ID in the payload
 	if wearableData.Id != wearableDataID {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID mismatch"})
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "ID mismatch"))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetWearableData(ctx, &health.ByIdRequest{Id: wearableDataID})
+	}) {
 		return
 	}
 
 	// Publish to Kafka
 	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaWearableDataTopic, "wearable_data.update", &wearableData); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update wearable data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to update wearable data "+err.Error()))
 		return
 	}
 
 	c.JSON(http.StatusAccepted, gin.H{"message": "Wearable data update request accepted"})
 }
 
+// PatchWearableData godoc
+// @Summary     Patch Wearable Data
+// @Description Partially update a wearable data record. The body is a JSON Merge Patch; with update_mask only the listed fields are updated and those absent from the body are cleared.
+// @Tags        WearableData
+// @Accept      json
+// @Produce     json
+// @Param       id          path     string true  "Wearable Data ID"
+// @Param       update_mask query    string false "Comma separated fields to update, defaults to the fields in the body"
+// @Param       wearableData body     health.WearableData true "Fields to update"
+// @Param       If-Match header string false "ETag of the version being replaced"
+// @Security    ApiKeyAuth
+// @Success     202     {object} map[string]interface{}
+// @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
+// @Failure     500     {object} map[string]interface{}
+// @Router      /v1/wearable-data/{id} [patch]
+func (h *WearableDataHandler) PatchWearableData(c *gin.Context) {
+	wearableDataID := c.Param("id")
+	var wearableData health.WearableData
+	updateMask, err := bindPatch(c, wearableDataID, &wearableData)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid patch "+err.Error()))
+		return
+	}
+	if errs := validation.WearableData(&wearableData, updateMask.GetPaths()...); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, response.ValidationErrorBody(c, errs))
+		return
+	}
+
+	// Ensure the record exists and the client is replacing the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetWearableData(ctx, &health.ByIdRequest{Id: wearableDataID})
+	}) {
+		return
+	}
+
+	// Publish only the changed fields and the mask to Kafka
+	patch := &health.WearableDataPatch{WearableData: &wearableData, UpdateMask: updateMask}
+	if err := h.kafkaProducer.ProduceMessage(c.Request.Context(), h.kafkaProducer.Cfg.KafkaWearableDataTopic, "wearable_data.patch", patch); err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to patch wearable data "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Wearable data patch request accepted"})
+}
+
 // DeleteWearableData godoc
 // @Summary     Delete Wearable Data
 // @Description Delete a wearable data record by its ID.
@@ -151,32 +217,45 @@ func (h *WearableDataHandler) UpdateWearableData(c *gin.Context) {
 // @Accept      json
 // @Produce     json
 // @Param       id   path     string true "Wearable Data ID"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Security    ApiKeyAuth
 // @Success     204     {object} map[string]interface{}
 // @Failure     400     {object} map[string]interface{}
+// @Failure     404     {object} map[string]interface{}
+// @Failure     412     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/wearable-data/{id} [delete]
 func (h *WearableDataHandler) DeleteWearableData(c *gin.Context) {
 	wearableDataID := c.Param("id")
 
+	// Ensure the record exists and the client is deleting the version it last read
+	if !checkCurrent(c, func(ctx context.Context) (versioned, error) {
+		return h.service.GetWearableData(ctx, &health.ByIdRequest{Id: wearableDataID})
+	}) {
+		return
+	}
+
 	// Call gRPC service to delete wearable data
-	_, err := h.service.DeleteWearableData(context.Background(), &health.ByIdRequest{Id: wearableDataID})
+	_, err := h.service.DeleteWearableData(c.Request.Context(), &health.ByIdRequest{Id: wearableDataID})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Wearable data not found " + err.Error()})
+				c.JSON(http.StatusNotFound, response.ErrorBody(c, "Wearable data not found "+err.Error()))
 				return
 			}
-			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+			c.JSON(http.StatusInternalServerError, response.ErrorBody(c, st.Message()))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete wearable data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to delete wearable data "+err.Error()))
 		return
 	}
 
-	c.JSON(http.StatusNoContent, gin.H{"message": "Wearable data deleted successfully " + err.Error()})
+	c.JSON(http.StatusNoContent, gin.H{"message": "Wearable data deleted successfully"})
 }
 
+// wearableDataSortFields are the fields ListWearableData can be sorted by.
+var wearableDataSortFields = []string{"recorded_timestamp", "device_type", "data_type", "created_at", "updated_at"}
+
 // ListWearableData godoc
 // @Summary     List Wearable Data
 // @Description Get a list of wearable data records.
@@ -187,28 +266,52 @@ func (h *WearableDataHandler) DeleteWearableData(c *gin.Context) {
 // @Param        device_type        query    string false  "Filter by device type"
 // @Param        data_type          query    string false  "Filter by data type"
 // @Param        recorded_timestamp query string false  "Filter by recorded timestamp (RFC3339 format)"
+// @Param        from       query    string false  "Earliest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        to         query    string false  "Latest recorded timestamp, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param        limit      query    int    false  "Page size, capped at the configured maximum"
+// @Param        page_token query    string false  "next_page_token of the previous page"
+// @Param        sort       query    string false  "Comma separated sort fields, \"-\" prefixed for descending (e.g. -recorded_timestamp)"
 // @Security    ApiKeyAuth
 // @Success     200     {object} health.ListWearableDataResponse
+// @Failure     400     {object} map[string]interface{}
 // @Failure     500     {object} map[string]interface{}
 // @Router      /v1/wearable-data [get]
 func (h *WearableDataHandler) ListWearableData(c *gin.Context) {
 	// Get query parameters for pagination and filtering
+	page, err := parsePageQuery(c, h.kafkaProducer.Cfg, wearableDataSortFields...)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid pagination parameters "+err.Error()))
+		return
+	}
+
+	recordedRange, err := parseTimestampRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorBody(c, "Invalid time range "+err.Error()))
+		return
+	}
+
 	userID := c.Query("user_id")
 	deviceType := c.Query("device_type")
 	dataType := c.Query("data_type")
 	recordedTimestamp := c.Query("recorded_timestamp")
 
 	// Use gRPC to get the wearable data from the service
-	grpcResponse, err := h.service.ListWearableData(context.Background(), &health.ListWearableDataRequest{
-		UserId:            userID,
-		DeviceType:        deviceType,
-		DataType:          dataType,
-		RecordedTimestamp: recordedTimestamp,
+	grpcResponse, err := h.service.ListWearableData(c.Request.Context(), &health.ListWearableDataRequest{
+		UserId:                userID,
+		DeviceType:            deviceType,
+		DataType:              dataType,
+		RecordedTimestamp:     recordedTimestamp,
+		RecordedTimestampFrom: recordedRange.From,
+		RecordedTimestampTo:   recordedRange.To,
+		PageSize:              page.PageSize,
+		PageToken:             page.PageToken,
+		OrderBy:               page.OrderBy,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get wearable data " + err.Error()})
+		c.JSON(http.StatusInternalServerError, response.ErrorBody(c, "Failed to get wearable data "+err.Error()))
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcResponse)
+	setPageLinks(c, grpcResponse.NextPageToken)
+	h.renderer.JSON(c, http.StatusOK, grpcResponse)
 }
@@ -0,0 +1,146 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+)
+
+// anyName is the full name of google.protobuf.Any.
+const anyName protoreflect.FullName = "google.protobuf.Any"
+
+// Renderer writes proto messages as JSON with protojson. Any fields are
+// rendered in their native form by the datavalue codec rather than as
+// {"@type": ...} objects.
+type Renderer struct {
+	options protojson.MarshalOptions
+}
+
+// NewRenderer creates a Renderer with the options in cfg.
+func NewRenderer(cfg config.Config) *Renderer {
+	return &Renderer{
+		options: protojson.MarshalOptions{
+			EmitUnpopulated: cfg.ResponseEmitUnpopulated,
+			UseProtoNames:   cfg.ResponseUseProtoNames,
+		},
+	}
+}
+
+// JSON writes msg with the given status code.
+func (r *Renderer) JSON(c *gin.Context, code int, msg proto.Message) {
+	body, err := r.Marshal(msg)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorBody(c, "Failed to render response "+err.Error()))
+		return
+	}
+
+	c.Data(code, "application/json; charset=utf-8", body)
+}
+
+// Marshal serializes msg with protojson.
+func (r *Renderer) Marshal(msg proto.Message) ([]byte, error) {
+	if !hasAny(msg.ProtoReflect().Descriptor(), map[protoreflect.FullName]bool{}) {
+		return r.options.Marshal(msg)
+	}
+
+	// Render everything but the Any fields with protojson, then splice those in
+	stripped := proto.Clone(msg)
+	clearAny(stripped.ProtoReflect())
+	body, err := r.options.Marshal(stripped)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var tree map[string]interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+	if err := r.spliceAny(msg.ProtoReflect(), tree); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(tree)
+}
+
+// spliceAny sets, in the JSON object of m, the native form of every Any field.
+func (r *Renderer) spliceAny(m protoreflect.Message, tree map[string]interface{}) error {
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+		name := fd.JSONName()
+		if r.options.UseProtoNames {
+			name = string(fd.Name())
+		}
+
+		switch {
+		case fd.Message().FullName() == anyName && !fd.IsList():
+			var encoded json.RawMessage
+			encoded, err = datavalue.Encode(v.Message().Interface().(*anypb.Any), r.options)
+			tree[name] = encoded
+		case fd.IsList():
+			items, _ := tree[name].([]interface{})
+			for i := 0; i < v.List().Len() && i < len(items) && err == nil; i++ {
+				if item, ok := items[i].(map[string]interface{}); ok {
+					err = r.spliceAny(v.List().Get(i).Message(), item)
+				}
+			}
+		default:
+			if child, ok := tree[name].(map[string]interface{}); ok {
+				err = r.spliceAny(v.Message(), child)
+			}
+		}
+		return err == nil
+	})
+	return err
+}
+
+// clearAny clears every Any field reachable from m.
+func clearAny(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil || fd.IsMap():
+		case fd.Message().FullName() == anyName && !fd.IsList():
+			m.Clear(fd)
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				clearAny(v.List().Get(i).Message())
+			}
+		default:
+			clearAny(v.Message())
+		}
+		return true
+	})
+}
+
+// hasAny reports whether messages of type md can contain an Any field.
+func hasAny(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Message() == nil || fd.IsMap() {
+			continue
+		}
+		if fd.Message().FullName() == anyName || hasAny(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
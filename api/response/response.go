@@ -0,0 +1,25 @@
+package response
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
+)
+
+// ErrorBody builds the JSON body of an error response, tagged with the
+// request ID so clients can quote it when reporting problems.
+func ErrorBody(c *gin.Context, message string) gin.H {
+	return gin.H{
+		"error":      message,
+		"request_id": c.GetString(requestid.ContextKey),
+	}
+}
+
+// ValidationErrorBody builds the JSON body of a 400 response listing every
+// invalid field of the request payload.
+func ValidationErrorBody(c *gin.Context, errs validation.Errors) gin.H {
+	body := ErrorBody(c, "Invalid request body")
+	body["fields"] = errs
+	return body
+}
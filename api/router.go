@@ -1,15 +1,23 @@
 package api
 
 import (
+	"log/slog"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/health-analytics-service/api-gateway-health-analytics/api/auth"
 	_ "github.com/health-analytics-service/api-gateway-health-analytics/api/docs"
 	"github.com/health-analytics-service/api-gateway-health-analytics/api/handlers"
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
 	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/config/logger"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+	"github.com/health-analytics-service/api-gateway-health-analytics/ratelimit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
-	"google.golang.org/grpc"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 // @title           Swagger Example API
@@ -20,74 +28,135 @@ import (
 // @name                        Authorization
 // @BasePath  /v1
 // @description					Description for what is this security definition being used
-func NewRouter(healthGrpcConn *grpc.ClientConn) *gin.Engine {
-	cfg := config.Load()
-	router := gin.Default()
-
-	handler := handlers.NewHandler(healthGrpcConn, &cfg)
+func NewRouter(cfg *config.Config, handler *handlers.Handler, log *slog.Logger) *gin.Engine {
+	router := gin.New()
+	// Tracing restores the request context once the request is served, so it
+	// goes first for the access log to see the attributes added downstream
+	router.Use(otelgin.Middleware(cfg.TracingServiceName))
+	router.Use(requestid.Middleware(), logger.GinMiddleware(log), gin.Recovery())
+	router.Use(metrics.GinMiddleware())
 
 	// Swagger documentation
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// Liveness and readiness probes
+	router.GET("/healthz", handler.ProbeHandler.Liveness)
+	router.GET("/readyz", handler.ProbeHandler.Readiness)
+
+	// Prometheus metrics
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
 	// API versioning
+	// Each group audits its requests before authenticating them, so that
+	// denied access is recorded too
 	v1 := router.Group("/v1")
-	v1.Use(auth.AuthMiddleware(&cfg))
+	v1.Use(ratelimit.ClientMiddleware(handler.RateLimiter))
 	{
 		// Genetic Data routes
-		geneticData := v1.Group("/genetic-data")
+		geneticData := v1.Group("/genetic-data", audit.Middleware(handler.AuditRecorder, audit.EntityGeneticData), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "genetic-data"), summary.Invalidation(handler.SummaryCache))
 		{
 			geneticData.POST("", handler.GeneticDataHandler.CreateGeneticData)
 			geneticData.GET(":id", handler.GeneticDataHandler.GetGeneticData)
 			geneticData.PUT(":id", handler.GeneticDataHandler.UpdateGeneticData)
+			geneticData.PATCH(":id", handler.GeneticDataHandler.PatchGeneticData)
 			geneticData.DELETE(":id", handler.GeneticDataHandler.DeleteGeneticData)
 			geneticData.GET("", handler.GeneticDataHandler.ListGeneticData)
 		}
 
 		// Health Recommendation routes
-		healthRecommendations := v1.Group("/health-recommendations")
+		healthRecommendations := v1.Group("/health-recommendations", audit.Middleware(handler.AuditRecorder, audit.EntityHealthRecommendation), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "health-recommendations"), summary.Invalidation(handler.SummaryCache))
 		{
 			healthRecommendations.POST("", handler.HealthRecommendationHandler.CreateHealthRecommendation)
 			healthRecommendations.GET(":id", handler.HealthRecommendationHandler.GetHealthRecommendation)
 			healthRecommendations.PUT(":id", handler.HealthRecommendationHandler.UpdateHealthRecommendation)
+			healthRecommendations.PATCH(":id", handler.HealthRecommendationHandler.PatchHealthRecommendation)
 			healthRecommendations.DELETE(":id", handler.HealthRecommendationHandler.DeleteHealthRecommendation)
 			healthRecommendations.GET("", handler.HealthRecommendationHandler.ListHealthRecommendations)
 		}
 
 		// Lifestyle Data routes
-		lifestyleData := v1.Group("/lifestyle-data")
+		lifestyleData := v1.Group("/lifestyle-data", audit.Middleware(handler.AuditRecorder, audit.EntityLifestyleData), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "lifestyle-data"), summary.Invalidation(handler.SummaryCache))
 		{
 			lifestyleData.POST("", handler.LifestyleDataHandler.CreateLifestyleData)
 			lifestyleData.GET(":id", handler.LifestyleDataHandler.GetLifestyleData)
 			lifestyleData.PUT(":id", handler.LifestyleDataHandler.UpdateLifestyleData)
+			lifestyleData.PATCH(":id", handler.LifestyleDataHandler.PatchLifestyleData)
 			lifestyleData.DELETE(":id", handler.LifestyleDataHandler.DeleteLifestyleData)
 			lifestyleData.GET("", handler.LifestyleDataHandler.ListLifestyleData)
 		}
 
 		// Medical Record routes
-		medicalRecords := v1.Group("/medical-records")
+		medicalRecords := v1.Group("/medical-records", audit.Middleware(handler.AuditRecorder, audit.EntityMedicalRecord), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "medical-records"), summary.Invalidation(handler.SummaryCache))
 		{
 			medicalRecords.POST("", handler.MedicalRecordHandler.CreateMedicalRecord)
 			medicalRecords.GET(":id", handler.MedicalRecordHandler.GetMedicalRecord)
 			medicalRecords.PUT(":id", handler.MedicalRecordHandler.UpdateMedicalRecord)
+			medicalRecords.PATCH(":id", handler.MedicalRecordHandler.PatchMedicalRecord)
 			medicalRecords.DELETE(":id", handler.MedicalRecordHandler.DeleteMedicalRecord)
 			medicalRecords.GET("", handler.MedicalRecordHandler.ListMedicalRecords)
 		}
 
 		// Wearable Data routes
-		wearableData := v1.Group("/wearable-data")
+		wearableData := v1.Group("/wearable-data", audit.Middleware(handler.AuditRecorder, audit.EntityWearableData), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "wearable-data"), summary.Invalidation(handler.SummaryCache))
 		{
 			wearableData.POST("", handler.WearableDataHandler.CreateWearableData)
+			wearableData.GET("aggregate", handler.WearableDataHandler.AggregateWearableData)
 			wearableData.GET(":id", handler.WearableDataHandler.GetWearableData)
 			wearableData.PUT(":id", handler.WearableDataHandler.UpdateWearableData)
+			wearableData.PATCH(":id", handler.WearableDataHandler.PatchWearableData)
 			wearableData.DELETE(":id", handler.WearableDataHandler.DeleteWearableData)
 			wearableData.GET("", handler.WearableDataHandler.ListWearableData)
 		}
 
+		// Sleep routes
+		sleep := v1.Group("/sleep", audit.Middleware(handler.AuditRecorder, audit.EntityLifestyleData), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "sleep"), summary.Invalidation(handler.SummaryCache))
+		{
+			sleep.POST("", handler.SleepHandler.CreateSleepData)
+			sleep.GET("", handler.SleepHandler.ListSleepData)
+		}
+
+		// Heart Rate routes
+		heartRate := v1.Group("/heart-rate", audit.Middleware(handler.AuditRecorder, audit.EntityWearableData), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "heart-rate"), summary.Invalidation(handler.SummaryCache))
+		{
+			heartRate.POST("", handler.HeartRateHandler.CreateHeartRateData)
+			heartRate.GET("", handler.HeartRateHandler.ListHeartRateData)
+		}
+
 		// Health Monitoring routes
-		healthMonitoring := v1.Group("/health-monitoring")
+		healthMonitoring := v1.Group("/health-monitoring", audit.Middleware(handler.AuditRecorder, audit.EntityHealthSummary), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "health-monitoring"))
 		{
 			healthMonitoring.GET("daily-summary/:user_id", handler.HealthMonitoringHandler.GetDailySummary)
 			healthMonitoring.GET("weekly-summary/:user_id", handler.HealthMonitoringHandler.GetWeeklySummary)
+			healthMonitoring.GET("monthly-summary/:user_id", handler.HealthMonitoringHandler.GetMonthlySummary)
+			healthMonitoring.GET("summary/:user_id", handler.HealthMonitoringHandler.GetRangeSummary)
+		}
+
+		// Health Goal routes
+		healthGoals := v1.Group("/health-goals", audit.Middleware(handler.AuditRecorder, audit.EntityHealthGoal), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "health-goals"))
+		{
+			healthGoals.POST("", handler.HealthGoalHandler.CreateHealthGoal)
+			healthGoals.GET(":id", handler.HealthGoalHandler.GetHealthGoal)
+			healthGoals.PUT(":id", handler.HealthGoalHandler.UpdateHealthGoal)
+			healthGoals.PATCH(":id", handler.HealthGoalHandler.PatchHealthGoal)
+			healthGoals.DELETE(":id", handler.HealthGoalHandler.DeleteHealthGoal)
+			healthGoals.GET("", handler.HealthGoalHandler.ListHealthGoals)
+			healthGoals.GET(":id/progress", handler.HealthGoalHandler.GetHealthGoalProgress)
+			healthGoals.POST(":id/evaluate", auth.RequireRoles("admin", "doctor"), handler.HealthGoalHandler.EvaluateHealthGoal)
+		}
+
+		// Recommendation engine routes
+		recommendationRules := v1.Group("/recommendation-rules", audit.Middleware(handler.AuditRecorder, audit.EntityHealthRecommendation), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "recommendation-rules"))
+		{
+			recommendationRules.GET("", handler.RecommendationEngineHandler.ListRecommendationRules)
+			recommendationRules.GET("evaluate/:user_id", auth.AuthorizationMiddleware(), handler.RecommendationEngineHandler.EvaluateRecommendationRules)
+			recommendationRules.POST("evaluate/:user_id", auth.RequireRoles("admin", "doctor"), summary.Invalidation(handler.SummaryCache), handler.RecommendationEngineHandler.GenerateRecommendations)
+		}
+
+		// Audit routes
+		auditEvents := v1.Group("/audit-events", audit.Middleware(handler.AuditRecorder, audit.EntityAuditEvent), auth.AuthMiddleware(cfg), ratelimit.Middleware(handler.RateLimiter, "audit-events"), auth.RequireRoles("admin"))
+		{
+			auditEvents.GET("", handler.AuditHandler.ListAuditEvents)
+			auditEvents.GET("verify", handler.AuditHandler.VerifyAuditLog)
 		}
 	}
 
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"time"
+)
+
+// Action describes what was done to a record.
+type Action string
+
+// Actions recorded by the gateway.
+const (
+	ActionRead   Action = "read"
+	ActionList   Action = "list"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Outcome describes how the access ended.
+type Outcome string
+
+// Outcomes recorded by the gateway.
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeDenied  Outcome = "denied"
+	OutcomeFailure Outcome = "failure"
+)
+
+// Entities whose access is audited.
+const (
+	EntityMedicalRecord        = "medical_record"
+	EntityGeneticData          = "genetic_data"
+	EntityLifestyleData        = "lifestyle_data"
+	EntityWearableData         = "wearable_data"
+	EntityHealthRecommendation = "health_recommendation"
+	EntityHealthSummary        = "health_summary"
+	EntityHealthGoal           = "health_goal"
+	EntityAuditEvent           = "audit_event"
+)
+
+// Event is a single access to protected health information.
+type Event struct {
+	ID         string    `json:"id"`
+	Timestamp  time.Time `json:"timestamp"`
+	ActorID    string    `json:"actor_id"`
+	ActorRole  string    `json:"actor_role"`
+	PatientID  string    `json:"patient_id,omitempty"`
+	Entity     string    `json:"entity"`
+	RecordID   string    `json:"record_id,omitempty"`
+	Action     Action    `json:"action"`
+	Outcome    Outcome   `json:"outcome"`
+	StatusCode int       `json:"status_code"`
+	SourceIP   string    `json:"source_ip"`
+	Method     string    `json:"method"`
+	Route      string    `json:"route"`
+	RequestID  string    `json:"request_id,omitempty"`
+
+	// Hash chain, filled in by the file sink.
+	PrevHash string `json:"prev_hash,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// Filter selects events in a search. Empty fields match everything.
+type Filter struct {
+	ActorID   string
+	PatientID string
+	Entity    string
+	RecordID  string
+	Action    Action
+	Outcome   Outcome
+	From      time.Time
+	To        time.Time
+	Limit     int
+}
+
+// Match reports whether the event satisfies the filter.
+func (f Filter) Match(e Event) bool {
+	switch {
+	case f.ActorID != "" && e.ActorID != f.ActorID:
+		return false
+	case f.PatientID != "" && e.PatientID != f.PatientID:
+		return false
+	case f.Entity != "" && e.Entity != f.Entity:
+		return false
+	case f.RecordID != "" && e.RecordID != f.RecordID:
+		return false
+	case f.Action != "" && e.Action != f.Action:
+		return false
+	case f.Outcome != "" && e.Outcome != f.Outcome:
+		return false
+	case !f.From.IsZero() && e.Timestamp.Before(f.From):
+		return false
+	case !f.To.IsZero() && e.Timestamp.After(f.To):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,272 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// scanChunkSize is the number of bytes scanBackward reads at a time.
+const scanChunkSize = 64 * 1024
+
+// errStopScan stops a scan early without failing it.
+var errStopScan = errors.New("stop scan")
+
+// FileSink appends events to a local file as JSON lines. Every event carries
+// the hash of the previous one, so removing or editing an entry breaks the
+// chain and is detected by Verify.
+type FileSink struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	lastHash string
+}
+
+// NewFileSink opens (or creates) the audit file and recovers the hash of the
+// last entry so the chain continues across restarts.
+func NewFileSink(path string) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+
+	lastHash, err := readLastHash(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	return &FileSink{path: path, file: file, lastHash: lastHash}, nil
+}
+
+// Write chains the events to the previous one and appends them to the file.
+func (s *FileSink) Write(_ context.Context, events ...Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var lines []byte
+	hash := s.lastHash
+	for _, event := range events {
+		event.PrevHash = hash
+		var err error
+		if hash, err = hashEvent(event); err != nil {
+			return err
+		}
+		event.Hash = hash
+
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit event: %w", err)
+		}
+		lines = append(append(lines, line...), '\n')
+	}
+	if _, err := s.file.Write(lines); err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+
+	s.lastHash = hash
+	return nil
+}
+
+// Search returns the most recent events matching the filter, newest first.
+// It reads the file backwards and stops once filter.Limit events are found.
+func (s *FileSink) Search(filter Filter) ([]Event, error) {
+	var events []Event
+	err := s.scanBackward(func(event Event) error {
+		if !filter.Match(event) {
+			return nil
+		}
+		events = append(events, event)
+		if filter.Limit > 0 && len(events) == filter.Limit {
+			return errStopScan
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopScan) {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// Verify walks the whole file and checks the hash chain. It returns the
+// number of verified entries and an error describing the first broken link.
+func (s *FileSink) Verify() (int, error) {
+	var (
+		count    int
+		prevHash string
+	)
+	err := s.scan(func(event Event) error {
+		if event.PrevHash != prevHash {
+			return fmt.Errorf("entry %d (%s): previous hash mismatch", count+1, event.ID)
+		}
+		hash, err := hashEvent(event)
+		if err != nil {
+			return err
+		}
+		if hash != event.Hash {
+			return fmt.Errorf("entry %d (%s): hash mismatch", count+1, event.ID)
+		}
+		prevHash = event.Hash
+		count++
+		return nil
+	})
+
+	return count, err
+}
+
+// Close closes the audit file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// scan calls fn for every event in the file, in write order. It reads through
+// its own handle without holding the write lock, so searches never stall
+// writes; a last line still being written is skipped.
+func (s *FileSink) scan(fn func(Event) error) error {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && line[len(line)-1] == '\n' {
+			var event Event
+			if err := json.Unmarshal(line, &event); err != nil {
+				return fmt.Errorf("failed to decode audit event: %w", err)
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read audit log: %w", err)
+		}
+	}
+}
+
+// scanBackward calls fn for every event in the file, newest first, reading
+// the file in chunks from its end. Like scan, it skips a last line still being
+// written.
+func (s *FileSink) scanBackward(fn func(Event) error) error {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	// pending holds the bytes from offset to the last complete line not yet
+	// decoded, once partial is cleared
+	offset := info.Size()
+	var pending []byte
+	partial := true
+	for {
+		if partial {
+			if i := bytes.LastIndexByte(pending, '\n'); i >= 0 {
+				pending, partial = pending[:i+1], false
+			}
+		}
+		for !partial && len(pending) > 0 {
+			// The first line may continue in the bytes before offset
+			i := bytes.LastIndexByte(pending[:len(pending)-1], '\n')
+			if i < 0 && offset > 0 {
+				break
+			}
+
+			var event Event
+			if err := json.Unmarshal(pending[i+1:], &event); err != nil {
+				return fmt.Errorf("failed to decode audit event: %w", err)
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+			pending = pending[:i+1]
+		}
+		if offset == 0 {
+			return nil
+		}
+
+		n := min(scanChunkSize, offset)
+		offset -= n
+		chunk := make([]byte, n, n+int64(len(pending)))
+		if _, err := file.ReadAt(chunk, offset); err != nil {
+			return fmt.Errorf("failed to read audit log: %w", err)
+		}
+		pending = append(chunk, pending...)
+	}
+}
+
+// hashEvent computes the chain hash of an event, excluding its own hash.
+func hashEvent(event Event) (string, error) {
+	event.Hash = ""
+	data, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// readLastHash returns the hash of the last event in the file, if any.
+func readLastHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	var last []byte
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) > 0 {
+			last = append(last[:0], scanner.Bytes()...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read audit log: %w", err)
+	}
+	if last == nil {
+		return "", nil
+	}
+
+	var event Event
+	if err := json.Unmarshal(last, &event); err != nil {
+		return "", fmt.Errorf("failed to decode last audit event: %w", err)
+	}
+
+	return event.Hash, nil
+}
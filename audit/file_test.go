@@ -0,0 +1,243 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+)
+
+// writeEvents writes n events to a new audit file and returns its path.
+func writeEvents(t *testing.T, n int) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink: %v", err)
+	}
+	for i := 0; i < n; i++ {
+		event := Event{
+			ID:        string(rune('a' + i)),
+			Timestamp: time.Date(2026, 10, 1, 0, i, 0, 0, time.UTC),
+			ActorID:   "u1",
+			PatientID: "p1",
+			Entity:    "medical_record",
+			Action:    ActionRead,
+			Outcome:   OutcomeSuccess,
+		}
+		if err := sink.Write(context.Background(), event); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return path
+}
+
+func TestFileSinkVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(lines [][]byte) [][]byte
+		count   int
+		wantErr string
+	}{
+		{
+			name:  "intact",
+			edit:  func(lines [][]byte) [][]byte { return lines },
+			count: 3,
+		},
+		{
+			name: "tampered line",
+			edit: func(lines [][]byte) [][]byte {
+				lines[1] = bytes.Replace(lines[1], []byte(`"patient_id":"p1"`), []byte(`"patient_id":"p2"`), 1)
+				return lines
+			},
+			count:   1,
+			wantErr: "entry 2 (b): hash mismatch",
+		},
+		{
+			name: "removed line",
+			edit: func(lines [][]byte) [][]byte {
+				return append(lines[:1:1], lines[2:]...)
+			},
+			count:   1,
+			wantErr: "entry 2 (c): previous hash mismatch",
+		},
+		{
+			name: "reordered lines",
+			edit: func(lines [][]byte) [][]byte {
+				lines[1], lines[2] = lines[2], lines[1]
+				return lines
+			},
+			count:   1,
+			wantErr: "entry 2 (c): previous hash mismatch",
+		},
+		{
+			name: "partial last line",
+			edit: func(lines [][]byte) [][]byte {
+				return append(lines, []byte(`{"id":"d"`))
+			},
+			count: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeEvents(t, 3)
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := bytes.SplitAfter(bytes.TrimSuffix(data, []byte("\n")), []byte("\n"))
+			lines[len(lines)-1] = append(lines[len(lines)-1], '\n')
+			if err := os.WriteFile(path, bytes.Join(tt.edit(lines), nil), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			sink := &FileSink{path: path}
+			count, err := sink.Verify()
+			if count != tt.count {
+				t.Errorf("Verify() count = %d, want %d", count, tt.count)
+			}
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("Verify() error = %v, want none", err)
+			case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+				t.Errorf("Verify() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileSinkContinuesChain(t *testing.T) {
+	path := writeEvents(t, 2)
+
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink: %v", err)
+	}
+	defer sink.Close()
+	if err := sink.Write(context.Background(), Event{ID: "c", Entity: "medical_record"}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if count, err := sink.Verify(); count != 3 || err != nil {
+		t.Errorf("Verify() = %d, %v, want 3, nil", count, err)
+	}
+}
+
+func TestFileSinkSearch(t *testing.T) {
+	path := writeEvents(t, 3)
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink: %v", err)
+	}
+	defer sink.Close()
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{name: "newest first", filter: Filter{}, want: "c,b,a"},
+		{name: "limit", filter: Filter{Limit: 2}, want: "c,b"},
+		{name: "from", filter: Filter{From: time.Date(2026, 10, 1, 0, 1, 0, 0, time.UTC)}, want: "c,b"},
+		{name: "to", filter: Filter{To: time.Date(2026, 10, 1, 0, 1, 0, 0, time.UTC)}, want: "b,a"},
+		{name: "no match", filter: Filter{PatientID: "p2"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := sink.Search(tt.filter)
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			ids := make([]string, len(events))
+			for i, event := range events {
+				ids[i] = event.ID
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Errorf("Search() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecorderFlushesOnClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	recorder, err := NewRecorder(config.Config{AuditSinks: []string{SinkFile}, AuditLogPath: path, AuditBufferSize: 16}, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if err := recorder.Record(context.Background(), Event{Entity: "medical_record"}); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := recorder.Record(context.Background(), Event{Entity: "medical_record"}); err == nil {
+		t.Error("Record after Close succeeded, want an error")
+	}
+
+	sink := &FileSink{path: path}
+	if count, err := sink.Verify(); count != 10 || err != nil {
+		t.Errorf("Verify() = %d, %v, want 10, nil", count, err)
+	}
+}
+
+func TestFileSinkSearchReadsBackwards(t *testing.T) {
+	// Enough events to span several chunks, with a last line still being written
+	path := writeEvents(t, 500)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteString(`{"id":"partial"`); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() <= 2*scanChunkSize {
+		t.Fatalf("audit log spans %d bytes, want more than two chunks", info.Size())
+	}
+
+	sink := &FileSink{path: path}
+	tests := []struct {
+		name   string
+		filter Filter
+		want   int
+	}{
+		{name: "limit", filter: Filter{Limit: 3}, want: 3},
+		{name: "all", filter: Filter{}, want: 500},
+		{name: "limit above matches", filter: Filter{Limit: 1000}, want: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := sink.Search(tt.filter)
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			if len(events) != tt.want {
+				t.Fatalf("Search() = %d events, want %d", len(events), tt.want)
+			}
+			for i, event := range events {
+				if want := string(rune('a' + 499 - i)); event.ID != want {
+					t.Fatalf("Search()[%d] = %q, want %q", i, event.ID, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+)
+
+// patientKey is the gin context key handlers use to report the patient whose
+// data was accessed, when it is not part of the request.
+const patientKey = "auditPatientID"
+
+// SetPatient records the patient whose data the current request touches.
+func SetPatient(c *gin.Context, patientID string) {
+	if patientID != "" {
+		c.Set(patientKey, patientID)
+	}
+}
+
+// Middleware emits one audit event per request to the routes of an entity.
+// It must run before the authentication middleware, so that requests denied
+// for lack of valid credentials are recorded as well.
+func Middleware(recorder *Recorder, entity string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		recordID := c.Param("id")
+		recorder.Record(c.Request.Context(), Event{
+			ActorID:    c.GetString("userID"),
+			ActorRole:  c.GetString("userRole"),
+			PatientID:  PatientID(c),
+			Entity:     entity,
+			RecordID:   recordID,
+			Action:     action(c.Request.Method, recordID),
+			Outcome:    outcome(c.Writer.Status()),
+			StatusCode: c.Writer.Status(),
+			SourceIP:   c.ClientIP(),
+			Method:     c.Request.Method,
+			Route:      c.FullPath(),
+			RequestID:  requestid.FromContext(c.Request.Context()),
+		})
+	}
+}
+
+// PatientID resolves the patient whose data the current request touches from
+// handler state, path or query.
+func PatientID(c *gin.Context) string {
+	if id := c.GetString(patientKey); id != "" {
+		return id
+	}
+	if id := c.Param("user_id"); id != "" {
+		return id
+	}
+	return c.Query("user_id")
+}
+
+func action(method, recordID string) Action {
+	switch method {
+	case http.MethodPost:
+		return ActionCreate
+	case http.MethodPut, http.MethodPatch:
+		return ActionUpdate
+	case http.MethodDelete:
+		return ActionDelete
+	}
+	if recordID == "" {
+		return ActionList
+	}
+	return ActionRead
+}
+
+func outcome(status int) Outcome {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return OutcomeDenied
+	case status >= http.StatusBadRequest:
+		return OutcomeFailure
+	}
+	return OutcomeSuccess
+}
@@ -0,0 +1,172 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/kafka"
+)
+
+// Sinks accepted in AUDIT_SINKS.
+const (
+	SinkKafka = "kafka"
+	SinkFile  = "file"
+)
+
+// errRecorderClosed is returned for events recorded after Close.
+var errRecorderClosed = errors.New("audit recorder is closed")
+
+// Sink persists audit events.
+type Sink interface {
+	Write(ctx context.Context, events ...Event) error
+}
+
+// KafkaSink publishes audit events to the dedicated audit topic.
+type KafkaSink struct {
+	producer *kafka.Producer
+	topic    string
+}
+
+// NewKafkaSink creates a new KafkaSink.
+func NewKafkaSink(producer *kafka.Producer, topic string) *KafkaSink {
+	return &KafkaSink{producer: producer, topic: topic}
+}
+
+// Write publishes the events in one batch, each keyed by patient so that a
+// patient's history stays ordered.
+func (s *KafkaSink) Write(ctx context.Context, events ...Event) error {
+	messages := make([]kafka.Message, len(events))
+	for i := range events {
+		messages[i] = kafka.Message{Key: events[i].PatientID, Value: &events[i]}
+	}
+	return s.producer.ProduceMessages(ctx, s.topic, messages...)
+}
+
+// Recorder fans audit events out to the configured sinks. Events are queued
+// and written by a single goroutine in batches of whatever is queued, so
+// requests never wait on the sinks unless the queue is full.
+type Recorder struct {
+	sinks []Sink
+	store *FileSink
+
+	mu     sync.RWMutex
+	closed bool
+	events chan Event
+	done   chan struct{}
+}
+
+// NewRecorder creates a Recorder with the sinks listed in cfg.AuditSinks.
+func NewRecorder(cfg config.Config, producer *kafka.Producer) (*Recorder, error) {
+	r := &Recorder{
+		events: make(chan Event, max(cfg.AuditBufferSize, 1)),
+		done:   make(chan struct{}),
+	}
+	for _, name := range cfg.AuditSinks {
+		switch strings.TrimSpace(name) {
+		case SinkKafka:
+			r.sinks = append(r.sinks, NewKafkaSink(producer, cfg.KafkaAuditTopic))
+		case SinkFile:
+			store, err := NewFileSink(cfg.AuditLogPath)
+			if err != nil {
+				return nil, err
+			}
+			r.sinks = append(r.sinks, store)
+			r.store = store
+		case "":
+		default:
+			return nil, fmt.Errorf("unsupported audit sink %q", name)
+		}
+	}
+
+	go r.run()
+	return r, nil
+}
+
+// Store returns the searchable file sink, or nil when it is not enabled.
+func (r *Recorder) Store() *FileSink {
+	return r.store
+}
+
+// Record stamps the event and queues it for the sinks. It blocks only while
+// the queue is full, and fails once the recorder is closed.
+func (r *Recorder) Record(ctx context.Context, event Event) error {
+	event.ID = uuid.NewString()
+	event.Timestamp = time.Now().UTC()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		slog.ErrorContext(ctx, "failed to record audit event",
+			slog.String("audit_id", event.ID),
+			slog.String("entity", event.Entity),
+			slog.String("error", errRecorderClosed.Error()),
+		)
+		return errRecorderClosed
+	}
+
+	r.events <- event
+	return nil
+}
+
+// Close writes the queued events and releases the file sink, if any.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.events)
+	}
+	r.mu.Unlock()
+	<-r.done
+
+	if r.store != nil {
+		return r.store.Close()
+	}
+	return nil
+}
+
+// run writes the queued events to every sink, batching the events queued
+// meanwhile. Failures are logged, but never stop the remaining sinks from
+// receiving the events.
+func (r *Recorder) run() {
+	defer close(r.done)
+
+	// Audit delivery must not depend on the lifetime of the request
+	ctx := context.Background()
+	batch := make([]Event, 0, cap(r.events))
+	for event := range r.events {
+		batch = append(batch[:0], event)
+	drain:
+		for len(batch) < cap(batch) {
+			select {
+			case event, ok := <-r.events:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, event)
+			default:
+				break drain
+			}
+		}
+
+		for _, sink := range r.sinks {
+			if err := sink.Write(ctx, batch...); err != nil {
+				for _, event := range batch {
+					slog.ErrorContext(ctx, "failed to record audit event",
+						slog.String("audit_id", event.ID),
+						slog.String("entity", event.Entity),
+						slog.String("request_id", event.RequestID),
+						slog.String("error", err.Error()),
+					)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+)
+
+// batchSink records the size of every batch written, holding the first one
+// until release is closed.
+type batchSink struct {
+	started chan struct{}
+	release chan struct{}
+	sizes   []int
+}
+
+func (s *batchSink) Write(_ context.Context, events ...Event) error {
+	if len(s.sizes) == 0 {
+		close(s.started)
+		<-s.release
+	}
+	s.sizes = append(s.sizes, len(events))
+	return nil
+}
+
+func TestRecorderBatchesQueuedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	recorder, err := NewRecorder(config.Config{AuditSinks: []string{SinkFile}, AuditLogPath: path, AuditBufferSize: 4}, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	sink := &batchSink{started: make(chan struct{}), release: make(chan struct{})}
+	recorder.sinks = append(recorder.sinks, sink)
+
+	// The first event is written alone while the next ones queue up, at
+	// most a queue's worth per batch
+	for i := 0; i < 9; i++ {
+		if err := recorder.Record(context.Background(), Event{Entity: "medical_record"}); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+		switch i {
+		case 0:
+			<-sink.started
+		case 4:
+			close(sink.release)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	var total int
+	for _, size := range sink.sizes {
+		total += size
+		if size > 4 {
+			t.Errorf("batch of %d events, want at most the queue size 4", size)
+		}
+	}
+	if total != 9 || !slices.Equal(sink.sizes[:2], []int{1, 4}) {
+		t.Errorf("batches = %v, want 9 events, the first alone and then the full queue", sink.sizes)
+	}
+
+	store := &FileSink{path: path}
+	if count, err := store.Verify(); count != 9 || err != nil {
+		t.Errorf("Verify() = %d, %v, want 9, nil", count, err)
+	}
+}
@@ -0,0 +1,124 @@
+package coalesce
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path"
+	"strings"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/identity"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+)
+
+// readPrefixes are the method name prefixes of the health service reads.
+var readPrefixes = []string{"Get", "List"}
+
+type bypassKey struct{}
+
+// Bypass returns a copy of ctx whose reads are never coalesced, for reads a
+// write depends on, such as the If-Match check, which must not be answered by
+// a call started before the latest write.
+func Bypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+// UnaryClientInterceptor coalesces identical reads in flight: while a call is
+// pending, the same call for the same caller waits for it and gets a copy of
+// its reply instead of reaching the health service. It must follow the
+// interceptors adding the caller's metadata, so the call made carries that of
+// the request making it; the request IDs of the requests it answers are
+// logged with it.
+//
+// Calls are identical when they have the same method, caller and request. The
+// request is compared in its serialized form, so the parameters the handlers
+// normalize (defaults, ranges, sort order) coalesce whatever their spelling in
+// the HTTP request.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	var group singleflight.Group
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		key, ok := callKey(ctx, method, req, reply)
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		coalesced := true
+		shared, err, _ := group.Do(key, func() (interface{}, error) {
+			coalesced = false
+			out := reply.(proto.Message).ProtoReflect().New().Interface()
+			return call{reply: out, requestID: requestid.FromContext(ctx)}, invoker(ctx, method, req, out, cc, opts...)
+		})
+		if coalesced {
+			metrics.ObserveCoalesced(method)
+			slog.DebugContext(ctx, "coalesced health service read",
+				slog.String("method", method),
+				slog.String("coalesced_request_id", shared.(call).requestID),
+			)
+		}
+
+		// The call ran with the context of another request: retry alone if
+		// that request went away while this one is still waiting
+		if coalesced && cancelled(err) && ctx.Err() == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		if err != nil {
+			return err
+		}
+
+		proto.Merge(reply.(proto.Message), shared.(call).reply)
+		return nil
+	}
+}
+
+// call is the outcome of a coalesced call, shared by the requests waiting on
+// it.
+type call struct {
+	reply     proto.Message
+	requestID string
+}
+
+// callKey returns the key identifying a read call, or false for calls that
+// are not coalesced.
+func callKey(ctx context.Context, method string, req, reply interface{}) (string, bool) {
+	if !isRead(method) || ctx.Value(bypassKey{}) != nil {
+		return "", false
+	}
+	in, ok := req.(proto.Message)
+	if _, isMessage := reply.(proto.Message); !ok || !isMessage {
+		return "", false
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+	if err != nil {
+		return "", false
+	}
+
+	caller, _ := identity.FromContext(ctx)
+	return strings.Join([]string{method, caller.UserID, caller.Role, string(body)}, "\x00"), true
+}
+
+func isRead(method string) bool {
+	name := path.Base(method)
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func cancelled(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	code := status.Code(err)
+	return code == codes.Canceled || code == codes.DeadlineExceeded
+}
@@ -0,0 +1,164 @@
+package coalesce
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/identity"
+)
+
+const (
+	getMethod    = "/health.MedicalRecordService/GetMedicalRecord"
+	deleteMethod = "/health.MedicalRecordService/DeleteMedicalRecord"
+)
+
+// invoker stands in for the health service. Every call waits until release
+// is closed or its context is done.
+type invoker struct {
+	mu      sync.Mutex
+	calls   int
+	started chan struct{}
+	release chan struct{}
+}
+
+func newInvoker() *invoker {
+	return &invoker{started: make(chan struct{}, 100), release: make(chan struct{})}
+}
+
+func (i *invoker) invoke(ctx context.Context, _ string, req, reply interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	i.mu.Lock()
+	i.calls++
+	i.mu.Unlock()
+	i.started <- struct{}{}
+
+	select {
+	case <-i.release:
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	proto.Merge(reply.(proto.Message), &health.MedicalRecord{Id: req.(*health.ByIdRequest).Id, UserId: "u1"})
+	return nil
+}
+
+// read is a call made through the interceptor.
+type read struct {
+	method string
+	id     string
+	caller string
+	bypass bool
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name      string
+		reads     []read
+		wantCalls int
+	}{
+		{
+			name:      "identical reads",
+			reads:     []read{{getMethod, "m1", "u1", false}, {getMethod, "m1", "u1", false}, {getMethod, "m1", "u1", false}},
+			wantCalls: 1,
+		},
+		{
+			name:      "different requests",
+			reads:     []read{{getMethod, "m1", "u1", false}, {getMethod, "m2", "u1", false}},
+			wantCalls: 2,
+		},
+		{
+			name:      "different callers",
+			reads:     []read{{getMethod, "m1", "u1", false}, {getMethod, "m1", "u2", false}},
+			wantCalls: 2,
+		},
+		{
+			name:      "writes",
+			reads:     []read{{deleteMethod, "m1", "u1", false}, {deleteMethod, "m1", "u1", false}},
+			wantCalls: 2,
+		},
+		{
+			name:      "bypassed reads",
+			reads:     []read{{getMethod, "m1", "u1", false}, {getMethod, "m1", "u1", true}},
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := UnaryClientInterceptor()
+			fake := newInvoker()
+
+			var wg sync.WaitGroup
+			replies := make([]*health.MedicalRecord, len(tt.reads))
+			errs := make([]error, len(tt.reads))
+			for i, r := range tt.reads {
+				ctx := identity.NewContext(context.Background(), identity.Caller{UserID: r.caller, Role: "doctor"})
+				if r.bypass {
+					ctx = Bypass(ctx)
+				}
+				replies[i] = &health.MedicalRecord{}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					errs[i] = interceptor(ctx, r.method, &health.ByIdRequest{Id: r.id}, replies[i], nil, fake.invoke)
+				}()
+
+				// Let the first call reach the service before the others
+				if i == 0 {
+					<-fake.started
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			close(fake.release)
+			wg.Wait()
+
+			if fake.calls != tt.wantCalls {
+				t.Errorf("health service called %d times, want %d", fake.calls, tt.wantCalls)
+			}
+			for i, r := range tt.reads {
+				if errs[i] != nil || replies[i].Id != r.id {
+					t.Errorf("read %d = %v, %v, want record %s", i, replies[i], errs[i], r.id)
+				}
+			}
+		})
+	}
+}
+
+func TestUnaryClientInterceptorLeaderCancelled(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+	fake := newInvoker()
+	caller := identity.NewContext(context.Background(), identity.Caller{UserID: "u1", Role: "doctor"})
+
+	leaderCtx, cancel := context.WithCancel(caller)
+	leaderErr := make(chan error, 1)
+	go func() {
+		leaderErr <- interceptor(leaderCtx, getMethod, &health.ByIdRequest{Id: "m1"}, &health.MedicalRecord{}, nil, fake.invoke)
+	}()
+	<-fake.started
+
+	reply := &health.MedicalRecord{}
+	followerErr := make(chan error, 1)
+	go func() {
+		followerErr <- interceptor(caller, getMethod, &health.ByIdRequest{Id: "m1"}, reply, nil, fake.invoke)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// The leader's request goes away, the follower retries on its own
+	cancel()
+	if err := <-leaderErr; err == nil {
+		t.Error("cancelled leader succeeded, want an error")
+	}
+	<-fake.started
+	close(fake.release)
+	if err := <-followerErr; err != nil || reply.Id != "m1" {
+		t.Errorf("follower = %v, %v, want record m1", reply, err)
+	}
+	if fake.calls != 2 {
+		t.Errorf("health service called %d times, want 2", fake.calls)
+	}
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -12,6 +14,12 @@ import (
 type Config struct {
 	HTTPPort      string
 	HealthSvcAddr string
+	// Health service load balancing
+	HealthSvcLBPolicy        string
+	HealthSvcHealthCheck     bool
+	HealthSvcHealthCheckName string
+	// Coalescing of identical health service reads
+	HealthSvcCoalesceReads bool
 	// Kafka Configuration
 	KafkaBrokers                   []string
 	KafkaBrokersTest               []string
@@ -20,12 +28,91 @@ type Config struct {
 	KafkaLifestyleDataTopic        string
 	KafkaWearableDataTopic         string
 	KafkaHealthRecommendationTopic string
+	KafkaAuditTopic                string
+	KafkaWearableAlertTopic        string
+	KafkaHealthGoalTopic           string
+	KafkaHealthGoalEventTopic      string
 
 	// JWT
 	JWTSecretKey string
 	JWTExpiry    int
 
-	LOG_PATH        string
+	// Caller identity forwarding
+	IdentitySigningKey string
+
+	// Audit
+	AuditSinks      []string
+	AuditLogPath    string
+	AuditBufferSize int
+
+	// Probes
+	ReadinessTimeout int
+
+	// List pagination
+	ListDefaultPageSize int
+	ListMaxPageSize     int
+
+	// Wearable data aggregation
+	AggregateMaxBuckets int
+
+	// Health summaries
+	SummaryMaxRangeDays int
+
+	// Health goals
+	GoalProgressMaxDays int
+
+	// Wearable anomaly detection
+	AnomalyDetectionEnabled bool
+	AnomalyRulesPath        string
+
+	// Recommendation engine
+	RecommendationEngineEnabled bool
+	RecommendationRulesPath     string
+	RecommendationRulesReload   int
+
+	// Summary cache
+	SummaryCacheEnabled bool
+	SummaryCacheBackend string
+	SummaryCacheSize    int
+	SummaryCacheTTL     int
+	SummaryCachePastTTL int
+	SummaryCacheSettle  int
+
+	// Response rendering
+	ResponseEmitUnpopulated bool
+	ResponseUseProtoNames   bool
+
+	// Rate limiting
+	RateLimitEnabled bool
+	RateLimitBackend string
+	RateLimitRules   string
+	RateLimitAPIKeys []string
+
+	// Redis
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+
+	// Tracing
+	TracingExporter     string
+	TracingServiceName  string
+	TracingOTLPEndpoint string
+	TracingOTLPInsecure bool
+	TracingSampleRatio  float64
+
+	// Graceful shutdown
+	ShutdownDelay   int
+	ShutdownTimeout int
+
+	// Logging
+	LOG_PATH          string
+	LogLevel          string
+	LogOutput         string
+	LogMaxSizeMB      int
+	LogMaxBackups     int
+	LogMaxAgeDays     int
+	LogRotateInterval int
+
 	TimelineSvcAddr string
 	MemorySvcAddr   string
 }
@@ -40,6 +127,10 @@ func Load() Config {
 
 	config.HTTPPort = cast.ToString(coalesce("HTTP_PORT", ":8081"))
 	config.HealthSvcAddr = cast.ToString(coalesce("HEALTH_PORT", ":8082"))
+	config.HealthSvcLBPolicy = cast.ToString(coalesce("HEALTH_LB_POLICY", "round_robin"))
+	config.HealthSvcHealthCheck = cast.ToBool(coalesce("HEALTH_CHECK_ENABLED", true))
+	config.HealthSvcHealthCheckName = cast.ToString(coalesce("HEALTH_CHECK_SERVICE_NAME", ""))
+	config.HealthSvcCoalesceReads = cast.ToBool(coalesce("HEALTH_COALESCE_READS", true))
 
 	config.KafkaBrokers = cast.ToStringSlice(coalesce("KAFKA_BROKERS", []string{"localhost:9092"}))
 	config.KafkaBrokersTest = cast.ToStringSlice(coalesce("KAFKA_BROKERS_Test", []string{"localhost:9092"}))
@@ -50,18 +141,101 @@ func Load() Config {
 	config.KafkaLifestyleDataTopic = cast.ToString(coalesce("KAFKA_LIFESTYLE_DATA_TOPIC", "lifestyle_data_topic"))
 	config.KafkaWearableDataTopic = cast.ToString(coalesce("KAFKA_WEARABLE_DATA_TOPIC", "wearable_data_topic"))
 	config.KafkaHealthRecommendationTopic = cast.ToString(coalesce("KAFKA_HEALTH_RECOMMENDATION_TOPIC", "health_recommendation_topic"))
+	config.KafkaAuditTopic = cast.ToString(coalesce("KAFKA_AUDIT_TOPIC", "audit_event_topic"))
+	config.KafkaWearableAlertTopic = cast.ToString(coalesce("KAFKA_WEARABLE_ALERT_TOPIC", "wearable_alert_topic"))
+	config.KafkaHealthGoalTopic = cast.ToString(coalesce("KAFKA_HEALTH_GOAL_TOPIC", "health_goal_topic"))
+	config.KafkaHealthGoalEventTopic = cast.ToString(coalesce("KAFKA_HEALTH_GOAL_EVENT_TOPIC", "health_goal_event_topic"))
+
+	// Audit
+	config.AuditSinks = strings.Split(cast.ToString(coalesce("AUDIT_SINKS", "kafka,file")), ",")
+	config.AuditLogPath = cast.ToString(coalesce("AUDIT_LOG_PATH", "logs/audit.log"))
+	config.AuditBufferSize = cast.ToInt(coalesce("AUDIT_BUFFER_SIZE", 1024))
+
+	config.ReadinessTimeout = cast.ToInt(coalesce("READINESS_TIMEOUT", 3))
+
+	config.ListDefaultPageSize = cast.ToInt(coalesce("LIST_DEFAULT_PAGE_SIZE", 50))
+	config.ListMaxPageSize = cast.ToInt(coalesce("LIST_MAX_PAGE_SIZE", 500))
+
+	config.AggregateMaxBuckets = cast.ToInt(coalesce("AGGREGATE_MAX_BUCKETS", 10000))
+
+	config.SummaryMaxRangeDays = cast.ToInt(coalesce("SUMMARY_MAX_RANGE_DAYS", 92))
+
+	config.GoalProgressMaxDays = cast.ToInt(coalesce("GOAL_PROGRESS_MAX_DAYS", 366))
+
+	config.AnomalyDetectionEnabled = cast.ToBool(coalesce("ANOMALY_DETECTION_ENABLED", true))
+	config.AnomalyRulesPath = cast.ToString(coalesce("ANOMALY_RULES_PATH", "config/anomaly/rules.json"))
+
+	config.RecommendationEngineEnabled = cast.ToBool(coalesce("RECOMMENDATION_ENGINE_ENABLED", true))
+	config.RecommendationRulesPath = cast.ToString(coalesce("RECOMMENDATION_RULES_PATH", "config/recommendation/rules.json"))
+	config.RecommendationRulesReload = cast.ToInt(coalesce("RECOMMENDATION_RULES_RELOAD", 30))
+
+	config.SummaryCacheEnabled = cast.ToBool(coalesce("SUMMARY_CACHE_ENABLED", true))
+	config.SummaryCacheBackend = cast.ToString(coalesce("SUMMARY_CACHE_BACKEND", "memory"))
+	config.SummaryCacheSize = cast.ToInt(coalesce("SUMMARY_CACHE_SIZE", 10000))
+	config.SummaryCacheTTL = cast.ToInt(coalesce("SUMMARY_CACHE_TTL", 60))
+	config.SummaryCachePastTTL = cast.ToInt(coalesce("SUMMARY_CACHE_PAST_TTL", 86400))
+	config.SummaryCacheSettle = cast.ToInt(coalesce("SUMMARY_CACHE_SETTLE", 30))
+
+	config.ResponseEmitUnpopulated = cast.ToBool(coalesce("RESPONSE_EMIT_UNPOPULATED", false))
+	config.ResponseUseProtoNames = cast.ToBool(coalesce("RESPONSE_USE_PROTO_NAMES", true))
+
+	config.RateLimitEnabled = cast.ToBool(coalesce("RATE_LIMIT_ENABLED", true))
+	config.RateLimitBackend = cast.ToString(coalesce("RATE_LIMIT_BACKEND", "memory"))
+	config.RateLimitRules = cast.ToString(coalesce("RATE_LIMIT_RULES", "client=1200/m:200;*=300/m:60;wearable-data=120/m:30;health-monitoring=30/m:10;*@admin=1200/m:200"))
+	config.RateLimitAPIKeys = strings.Split(cast.ToString(coalesce("RATE_LIMIT_API_KEYS", "")), ",")
+
+	config.RedisAddr = cast.ToString(coalesce("REDIS_ADDR", "localhost:6379"))
+	config.RedisPassword = cast.ToString(coalesce("REDIS_PASSWORD", ""))
+	config.RedisDB = cast.ToInt(coalesce("REDIS_DB", 0))
+
+	config.TracingExporter = cast.ToString(coalesce("OTEL_TRACES_EXPORTER", "none"))
+	config.TracingServiceName = cast.ToString(coalesce("OTEL_SERVICE_NAME", "api-gateway-health-analytics"))
+	config.TracingOTLPEndpoint = cast.ToString(coalesce("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"))
+	config.TracingOTLPInsecure = cast.ToBool(coalesce("OTEL_EXPORTER_OTLP_INSECURE", true))
+	config.TracingSampleRatio = cast.ToFloat64(coalesce("OTEL_TRACES_SAMPLER_ARG", 1.0))
+
+	config.ShutdownDelay = cast.ToInt(coalesce("SHUTDOWN_DELAY", 5))
+	config.ShutdownTimeout = cast.ToInt(coalesce("SHUTDOWN_TIMEOUT", 30))
 
 	config.LOG_PATH = cast.ToString(coalesce("LOG_PATH", "logs/info.log"))
+	config.LogLevel = cast.ToString(coalesce("LOG_LEVEL", "info"))
+	config.LogOutput = cast.ToString(coalesce("LOG_OUTPUT", "both"))
+	config.LogMaxSizeMB = cast.ToInt(coalesce("LOG_MAX_SIZE_MB", 100))
+	config.LogMaxBackups = cast.ToInt(coalesce("LOG_MAX_BACKUPS", 7))
+	config.LogMaxAgeDays = cast.ToInt(coalesce("LOG_MAX_AGE_DAYS", 30))
+	config.LogRotateInterval = cast.ToInt(coalesce("LOG_ROTATE_INTERVAL", 24))
 
 	// JWT Configuration
 	config.JWTSecretKey = cast.ToString(coalesce("JWT_SECRET_KEY", "your_secret_key"))
 	config.JWTExpiry = cast.ToInt(coalesce("JWT_EXPIRY", 60))
 
+	config.IdentitySigningKey = cast.ToString(coalesce("IDENTITY_SIGNING_KEY", ""))
+
 	config.TimelineSvcAddr = cast.ToString(coalesce("TIME_LINE_SERVICE_port", "timeline:9091"))
 	config.MemorySvcAddr = cast.ToString(coalesce("MEMORY_SERVICE_port", "memory:9090"))
 	return config
 }
 
+// KafkaTopics returns all Kafka topics the gateway publishes to.
+func (c Config) KafkaTopics() []string {
+	topics := []string{
+		c.KafkaMedicalRecordTopic,
+		c.KafkaGeneticDataTopic,
+		c.KafkaLifestyleDataTopic,
+		c.KafkaWearableDataTopic,
+		c.KafkaHealthRecommendationTopic,
+		c.KafkaHealthGoalTopic,
+		c.KafkaHealthGoalEventTopic,
+	}
+	if c.AnomalyDetectionEnabled {
+		topics = append(topics, c.KafkaWearableAlertTopic)
+	}
+	if slices.Contains(c.AuditSinks, "kafka") {
+		topics = append(topics, c.KafkaAuditTopic)
+	}
+	return topics
+}
+
 func coalesce(key string, defaultValue interface{}) interface{} {
 	val, exists := os.LookupEnv(key)
 
@@ -1,34 +1,119 @@
 package logger
 
 import (
+	"context"
+	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+)
+
+// Log outputs accepted in LOG_OUTPUT.
+const (
+	OutputStdout = "stdout"
+	OutputFile   = "file"
+	OutputBoth   = "both"
 )
 
+// Logger is the structured JSON logger used across the gateway.
 type Logger struct {
-	INFO  *log.Logger
-	WARN  *log.Logger
-	ERROR *log.Logger
-	DEBUG *log.Logger
-	TRACE *log.Logger
+	*slog.Logger
+
+	file   *lumberjack.Logger
+	cancel context.CancelFunc
 }
 
-func NewLogger(basepath, path string) *Logger {
+// NewLogger creates a JSON logger writing to stdout and/or LOG_PATH. The log
+// file is rotated when it exceeds LOG_MAX_SIZE_MB and every LOG_ROTATE_INTERVAL.
+func NewLogger(cfg config.Config) (*Logger, error) {
+	level, err := parseLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+
 	l := &Logger{}
 
-	fullpath := basepath + "/" + path
-	var file, err = os.OpenFile(fullpath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		panic(err)
+	var writers []io.Writer
+	switch cfg.LogOutput {
+	case OutputStdout:
+		writers = append(writers, os.Stdout)
+	case OutputFile:
+		l.file = newRotatingFile(cfg)
+		writers = append(writers, l.file)
+	case "", OutputBoth:
+		l.file = newRotatingFile(cfg)
+		writers = append(writers, os.Stdout, l.file)
+	default:
+		return nil, fmt.Errorf("unsupported log output %q", cfg.LogOutput)
+	}
+
+	handler := slog.NewJSONHandler(io.MultiWriter(writers...), &slog.HandlerOptions{Level: level})
+	l.Logger = slog.New(&ContextHandler{Handler: handler})
+
+	if l.file != nil && cfg.LogRotateInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		l.cancel = cancel
+		go l.rotateEvery(ctx, time.Duration(cfg.LogRotateInterval)*time.Hour)
 	}
-	multiWriter := io.MultiWriter(os.Stdout, file)
 
-	l.INFO = log.New(multiWriter, "[INFO]  ", log.Lshortfile|log.LstdFlags)
-	l.WARN = log.New(multiWriter, "[WARN]  ", log.Lshortfile|log.LstdFlags)
-	l.ERROR = log.New(multiWriter, "[ERROR]  ", log.Lshortfile|log.LstdFlags)
-	l.DEBUG = log.New(multiWriter, "[DEBUG]  ", log.Lshortfile|log.LstdFlags)
-	l.TRACE = log.New(multiWriter, "[TRACE]  ", log.Lshortfile|log.LstdFlags)
+	return l, nil
+}
 
-	return l
+// Close stops time based rotation and closes the log file.
+func (l *Logger) Close() error {
+	if l.cancel != nil {
+		l.cancel()
+	}
+	if l.file != nil {
+		return l.file.Close()
+	}
+	return nil
+}
+
+// rotateEvery rotates the log file on a fixed interval until ctx is done.
+func (l *Logger) rotateEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.file.Rotate(); err != nil {
+				l.Error("failed to rotate log file", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+func newRotatingFile(cfg config.Config) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   cfg.LOG_PATH,
+		MaxSize:    cfg.LogMaxSizeMB,
+		MaxBackups: cfg.LogMaxBackups,
+		MaxAge:     cfg.LogMaxAgeDays,
+		Compress:   true,
+	}
+}
+
+func parseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("unsupported log level %q", level)
+	}
 }
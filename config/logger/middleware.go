@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GinMiddleware writes one structured access log entry per request and
+// stores the route and request ID in the request context so that every entry
+// logged while serving the request carries them. It must run after
+// requestid.Middleware.
+func GinMiddleware(log *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		ctx := AppendCtx(c.Request.Context(),
+			slog.String("request_id", requestid.FromContext(c.Request.Context())),
+			slog.String("route", c.FullPath()),
+		)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", c.Writer.Status()),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.String()))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case c.Writer.Status() >= 500:
+			level = slog.LevelError
+		case c.Writer.Status() >= 400:
+			level = slog.LevelWarn
+		}
+
+		log.LogAttrs(c.Request.Context(), level, "http request", attrs...)
+	}
+}
+
+// UnaryClientInterceptor logs failed gRPC calls to the health service together
+// with the upstream status code.
+func UnaryClientInterceptor(log *slog.Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			log.LogAttrs(ctx, slog.LevelWarn, "health service call failed",
+				slog.String("grpc_method", method),
+				slog.String("upstream_code", status.Code(err).String()),
+				slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+				slog.String("error", err.Error()),
+			)
+		}
+
+		return err
+	}
+}
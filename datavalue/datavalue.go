@@ -0,0 +1,120 @@
+package datavalue
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// typed maps data types to the messages their data_value is stored as. Data
+// types not listed here are stored as google.protobuf.Struct or Value.
+var typed = map[string]func() proto.Message{
+	"sleep":      func() proto.Message { return &health.SleepData{} },
+	"heart_rate": func() proto.Message { return &health.HeartRateData{} },
+}
+
+// Decode converts the JSON data_value of a request into an Any. For data types
+// with a typed message the value must be an object matching that message;
+// otherwise objects are stored as google.protobuf.Struct and any other JSON as
+// google.protobuf.Value.
+func Decode(raw json.RawMessage, dataType string) (*anypb.Any, error) {
+	var msg proto.Message
+	if newTyped, ok := typed[dataType]; ok {
+		msg = newTyped()
+		if err := protojson.Unmarshal(raw, msg); err != nil {
+			return nil, fmt.Errorf("invalid %s value: %w", dataType, err)
+		}
+	} else {
+		value := &structpb.Value{}
+		if err := protojson.Unmarshal(raw, value); err != nil {
+			return nil, err
+		}
+		msg = value
+		if s := value.GetStructValue(); s != nil {
+			msg = s
+		}
+	}
+
+	return anypb.New(msg)
+}
+
+// Encode renders an Any as native JSON: the object or value it was decoded
+// from, marshalled with options. Values written before the codec existed held
+// raw JSON and are returned as is; values of unknown types keep the protojson
+// form of an Any.
+func Encode(v *anypb.Any, options protojson.MarshalOptions) (json.RawMessage, error) {
+	if v == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	msg, err := v.UnmarshalNew()
+	if err != nil {
+		if json.Valid(v.GetValue()) {
+			return bytes.TrimSpace(v.GetValue()), nil
+		}
+		return json.Marshal(map[string]string{
+			"@type": v.GetTypeUrl(),
+			"value": base64.StdEncoding.EncodeToString(v.GetValue()),
+		})
+	}
+
+	return options.Marshal(msg)
+}
+
+// Unpack reads an Any into the typed message msg. Values stored as Struct or
+// as raw JSON, before a typed message existed for their data type, are
+// converted when their fields match msg.
+func Unpack(v *anypb.Any, msg proto.Message) error {
+	if v == nil {
+		return fmt.Errorf("no value")
+	}
+	if v.MessageIs(msg) {
+		return v.UnmarshalTo(msg)
+	}
+
+	raw, err := Encode(v, protojson.MarshalOptions{UseProtoNames: true})
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(raw, msg)
+}
+
+// Number returns the measurement held by a data_value: the reading of a typed
+// message, a JSON number, or the "value" field of an object. It reports false
+// for values without a single numeric reading.
+func Number(v *anypb.Any) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+
+	if msg, err := v.UnmarshalNew(); err == nil {
+		switch m := msg.(type) {
+		case *health.HeartRateData:
+			return float64(m.HeartRate), true
+		case *health.SleepData:
+			return float64(m.SleepDuration), true
+		}
+	}
+
+	raw, err := Encode(v, protojson.MarshalOptions{})
+	if err != nil {
+		return 0, false
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0, false
+	}
+	if object, ok := value.(map[string]interface{}); ok {
+		value = object["value"]
+	}
+	number, ok := value.(float64)
+	return number, ok
+}
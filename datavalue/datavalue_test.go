@@ -0,0 +1,164 @@
+package datavalue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestDecodeEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		dataType string
+		wantType string
+		// want is the JSON Encode renders, when it differs from raw.
+		want    string
+		wantErr bool
+	}{
+		{name: "typed sleep", raw: `{"sleep_duration":"28800000","sleep_quality":"Good"}`, dataType: "sleep", wantType: "health.SleepData"},
+		{name: "typed sleep from a number", raw: `{"sleep_duration":28800000}`, dataType: "sleep", wantType: "health.SleepData", want: `{"sleep_duration":"28800000"}`},
+		{name: "typed heart rate", raw: `{"heart_rate":72}`, dataType: "heart_rate", wantType: "health.HeartRateData"},
+		{name: "object", raw: `{"value":8500,"unit":"steps"}`, dataType: "steps", wantType: "google.protobuf.Struct", want: `{"unit":"steps","value":8500}`},
+		{name: "number", raw: `8500`, dataType: "steps", wantType: "google.protobuf.Value"},
+		{name: "string", raw: `"vegetarian"`, dataType: "diet", wantType: "google.protobuf.Value"},
+		{name: "array", raw: `[1,2]`, dataType: "steps", wantType: "google.protobuf.Value"},
+		{name: "typed value of the wrong shape", raw: `8`, dataType: "sleep", wantErr: true},
+		{name: "unknown field of a typed value", raw: `{"hours":8}`, dataType: "sleep", wantErr: true},
+		{name: "invalid json", raw: `{`, dataType: "steps", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Decode(json.RawMessage(tt.raw), tt.dataType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode(%s) error = %v, want error %t", tt.raw, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := string(v.MessageName()); got != tt.wantType {
+				t.Errorf("Decode(%s) type = %s, want %s", tt.raw, got, tt.wantType)
+			}
+
+			got, err := Encode(v, protojson.MarshalOptions{UseProtoNames: true})
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			want := tt.want
+			if want == "" {
+				want = tt.raw
+			}
+			if !jsonEqual(t, got, want) {
+				t.Errorf("Encode() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodeLegacyValues(t *testing.T) {
+	tests := []struct {
+		name string
+		v    *anypb.Any
+		want string
+	}{
+		{name: "nil", v: nil, want: `null`},
+		{name: "raw json", v: &anypb.Any{TypeUrl: "type.googleapis.com/legacy.Json", Value: []byte(` {"value":1} `)}, want: `{"value":1}`},
+		{name: "unknown type", v: &anypb.Any{TypeUrl: "type.googleapis.com/legacy.Blob", Value: []byte{0xff}}, want: `{"@type":"type.googleapis.com/legacy.Blob","value":"/w=="}`},
+	}
+
+	for _, tt := range tests {
+		got, err := Encode(tt.v, protojson.MarshalOptions{})
+		if err != nil {
+			t.Fatalf("%s: Encode() error = %v", tt.name, err)
+		}
+		if !jsonEqual(t, got, tt.want) {
+			t.Errorf("%s: Encode() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUnpack(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		dataType string
+		want     int32
+		wantErr  bool
+	}{
+		{name: "typed", raw: `{"heart_rate":72}`, dataType: "heart_rate", want: 72},
+		{name: "stored as a struct", raw: `{"heart_rate":72,"device":"watch"}`, dataType: "legacy", want: 72},
+		{name: "not an object", raw: `72`, dataType: "legacy", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		v, err := Decode(json.RawMessage(tt.raw), tt.dataType)
+		if err != nil {
+			t.Fatalf("%s: Decode() error = %v", tt.name, err)
+		}
+		var heartRate health.HeartRateData
+		err = Unpack(v, &heartRate)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: Unpack() error = %v, want error %t", tt.name, err, tt.wantErr)
+		}
+		if heartRate.HeartRate != tt.want {
+			t.Errorf("%s: Unpack() heart_rate = %d, want %d", tt.name, heartRate.HeartRate, tt.want)
+		}
+	}
+
+	if err := Unpack(nil, &health.HeartRateData{}); err == nil {
+		t.Error("Unpack(nil) succeeded, want an error")
+	}
+}
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		dataType string
+		want     float64
+		wantOK   bool
+	}{
+		{name: "heart rate", raw: `{"heart_rate":72}`, dataType: "heart_rate", want: 72, wantOK: true},
+		{name: "sleep", raw: `{"sleep_duration":28800000}`, dataType: "sleep", want: 28800000, wantOK: true},
+		{name: "number", raw: `8500`, dataType: "steps", want: 8500, wantOK: true},
+		{name: "value field", raw: `{"value":97.5,"unit":"%"}`, dataType: "oxygen_saturation", want: 97.5, wantOK: true},
+		{name: "object without value", raw: `{"systolic":120,"diastolic":80}`, dataType: "blood_pressure"},
+		{name: "string", raw: `"vegetarian"`, dataType: "diet"},
+	}
+
+	for _, tt := range tests {
+		v, err := Decode(json.RawMessage(tt.raw), tt.dataType)
+		if err != nil {
+			t.Fatalf("%s: Decode() error = %v", tt.name, err)
+		}
+		got, ok := Number(v)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("%s: Number() = %v, %t, want %v, %t", tt.name, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if _, ok := Number(nil); ok {
+		t.Error("Number(nil) reported a value")
+	}
+}
+
+// jsonEqual reports whether got and want hold the same JSON value.
+func jsonEqual(t *testing.T, got []byte, want string) bool {
+	t.Helper()
+
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("invalid JSON %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("invalid JSON %s: %v", want, err)
+	}
+	gotJSON, _ := json.Marshal(g)
+	wantJSON, _ := json.Marshal(w)
+	return string(gotJSON) == string(wantJSON)
+}
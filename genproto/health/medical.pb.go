@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -190,7 +191,7 @@ type GeneticData struct {
 	Id           string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId       string     `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	DataType     string     `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue    *anypb.Any `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty" swaggertype:"string"`
+	DataValue    *anypb.Any `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty" swaggertype:"object"`
 	AnalysisDate string     `protobuf:"bytes,5,opt,name=analysis_date,json=analysisDate,proto3" json:"analysis_date,omitempty"`
 	CreatedAt    string     `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt    string     `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
@@ -286,7 +287,7 @@ type LifestyleData struct {
 	Id           string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId       string     `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	DataType     string     `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue    *anypb.Any `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty" swaggertype:"string"`
+	DataValue    *anypb.Any `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty" swaggertype:"object"`
 	RecordedDate string     `protobuf:"bytes,5,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
 	CreatedAt    string     `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt    string     `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
@@ -383,7 +384,7 @@ type WearableData struct {
 	UserId            string     `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	DeviceType        string     `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
 	DataType          string     `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue         *anypb.Any `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty" swaggertype:"string"`
+	DataValue         *anypb.Any `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty" swaggertype:"object"`
 	RecordedTimestamp string     `protobuf:"bytes,6,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
 	CreatedAt         string     `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         string     `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
@@ -573,20 +574,29 @@ func (x *HealthRecommendation) GetUpdatedAt() string {
 	return ""
 }
 
-// Sleep Data
-type SleepData struct {
+// Health Goals. Progress is the sum of the numeric values of data_type
+// recorded by source over each period of the goal.
+type HealthGoal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SleepDuration int64  `protobuf:"varint,2,opt,name=sleep_duration,json=sleepDuration,proto3" json:"sleep_duration,omitempty"` // Sleep duration in milliseconds
-	SleepQuality  string `protobuf:"bytes,3,opt,name=sleep_quality,json=sleepQuality,proto3" json:"sleep_quality,omitempty"`     // Subjective sleep quality (e.g., "Good", "Average", "Poor")
-	RecordedDate  string `protobuf:"bytes,4,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`     // Date when the sleep data was recorded (YYYY-MM-DD)
-}
-
-func (x *SleepData) Reset() {
-	*x = SleepData{}
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source      string  `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                        // wearable or lifestyle
+	DataType    string  `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`    // Data type of the source, e.g. steps, sleep or exercise
+	Target      float64 `protobuf:"fixed64,5,opt,name=target,proto3" json:"target,omitempty"`                      // In the unit of the data, e.g. steps or milliseconds of sleep
+	Period      string  `protobuf:"bytes,6,opt,name=period,proto3" json:"period,omitempty"`                        // daily or weekly
+	Direction   string  `protobuf:"bytes,7,opt,name=direction,proto3" json:"direction,omitempty"`                  // at_least (default) or at_most the target
+	StartDate   string  `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // First day of the goal (YYYY-MM-DD), weekly periods start on its weekday
+	EndDate     string  `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Last day of the goal (YYYY-MM-DD), empty while open-ended
+	Description string  `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   string  `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string  `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *HealthGoal) Reset() {
+	*x = HealthGoal{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -594,13 +604,13 @@ func (x *SleepData) Reset() {
 	}
 }
 
-func (x *SleepData) String() string {
+func (x *HealthGoal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SleepData) ProtoMessage() {}
+func (*HealthGoal) ProtoMessage() {}
 
-func (x *SleepData) ProtoReflect() protoreflect.Message {
+func (x *HealthGoal) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,52 +622,111 @@ func (x *SleepData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SleepData.ProtoReflect.Descriptor instead.
-func (*SleepData) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthGoal.ProtoReflect.Descriptor instead.
+func (*HealthGoal) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SleepData) GetUserId() string {
+func (x *HealthGoal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HealthGoal) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SleepData) GetSleepDuration() int64 {
+func (x *HealthGoal) GetSource() string {
 	if x != nil {
-		return x.SleepDuration
+		return x.Source
+	}
+	return ""
+}
+
+func (x *HealthGoal) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *HealthGoal) GetTarget() float64 {
+	if x != nil {
+		return x.Target
 	}
 	return 0
 }
 
-func (x *SleepData) GetSleepQuality() string {
+func (x *HealthGoal) GetPeriod() string {
 	if x != nil {
-		return x.SleepQuality
+		return x.Period
 	}
 	return ""
 }
 
-func (x *SleepData) GetRecordedDate() string {
+func (x *HealthGoal) GetDirection() string {
 	if x != nil {
-		return x.RecordedDate
+		return x.Direction
 	}
 	return ""
 }
 
-// Heart Rate Data
-type HeartRateData struct {
+func (x *HealthGoal) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *HealthGoal) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *HealthGoal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HealthGoal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *HealthGoal) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Progress of a health goal over one period
+type HealthGoalPeriod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId            string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	HeartRate         int32  `protobuf:"varint,2,opt,name=heart_rate,json=heartRate,proto3" json:"heart_rate,omitempty"`                        // Heart rate in beats per minute (BPM)
-	RecordedTimestamp string `protobuf:"bytes,3,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"` // Timestamp when the heart rate was recorded (RFC3339 format)
+	StartDate string  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD
+	EndDate   string  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive
+	Value     float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Percent   float64 `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"` // Value as a percentage of the target
+	Achieved  bool    `protobuf:"varint,5,opt,name=achieved,proto3" json:"achieved,omitempty"`
+	Complete  bool    `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"` // Whether the period has ended
 }
 
-func (x *HeartRateData) Reset() {
-	*x = HeartRateData{}
+func (x *HealthGoalPeriod) Reset() {
+	*x = HealthGoalPeriod{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,13 +734,13 @@ func (x *HeartRateData) Reset() {
 	}
 }
 
-func (x *HeartRateData) String() string {
+func (x *HealthGoalPeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartRateData) ProtoMessage() {}
+func (*HealthGoalPeriod) ProtoMessage() {}
 
-func (x *HeartRateData) ProtoReflect() protoreflect.Message {
+func (x *HealthGoalPeriod) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,41 +752,66 @@ func (x *HeartRateData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartRateData.ProtoReflect.Descriptor instead.
-func (*HeartRateData) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthGoalPeriod.ProtoReflect.Descriptor instead.
+func (*HealthGoalPeriod) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *HeartRateData) GetUserId() string {
+func (x *HealthGoalPeriod) GetStartDate() string {
 	if x != nil {
-		return x.UserId
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *HeartRateData) GetHeartRate() int32 {
+func (x *HealthGoalPeriod) GetEndDate() string {
 	if x != nil {
-		return x.HeartRate
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *HealthGoalPeriod) GetValue() float64 {
+	if x != nil {
+		return x.Value
 	}
 	return 0
 }
 
-func (x *HeartRateData) GetRecordedTimestamp() string {
+func (x *HealthGoalPeriod) GetPercent() float64 {
 	if x != nil {
-		return x.RecordedTimestamp
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-// Empty Message
-type Empty struct {
+func (x *HealthGoalPeriod) GetAchieved() bool {
+	if x != nil {
+		return x.Achieved
+	}
+	return false
+}
+
+func (x *HealthGoalPeriod) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type HealthGoalProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Goal          *HealthGoal         `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	Periods       []*HealthGoalPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`                                   // Oldest first
+	CurrentStreak int32               `protobuf:"varint,3,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"` // Achieved periods in a row up to the latest one, the current period counting once achieved
+	LongestStreak int32               `protobuf:"varint,4,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *HealthGoalProgress) Reset() {
+	*x = HealthGoalProgress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,13 +819,13 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *HealthGoalProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*HealthGoalProgress) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *HealthGoalProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -743,26 +837,61 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthGoalProgress.ProtoReflect.Descriptor instead.
+func (*HealthGoalProgress) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{8}
 }
 
-// Request messages for List methods with filters
-type ListMedicalRecordsRequest struct {
+func (x *HealthGoalProgress) GetGoal() *HealthGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *HealthGoalProgress) GetPeriods() []*HealthGoalPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *HealthGoalProgress) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *HealthGoalProgress) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+// Event published when a period of a health goal ends
+type HealthGoalEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RecordType  string `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
-	RecordDate  string `protobuf:"bytes,3,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	DoctorId    string `protobuf:"bytes,5,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-}
-
-func (x *ListMedicalRecordsRequest) Reset() {
-	*x = ListMedicalRecordsRequest{}
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // <goal_id>:<start_date>, the same whenever the period is evaluated
+	GoalId      string  `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	UserId      string  `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Outcome     string  `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"` // achieved or missed
+	DataType    string  `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Period      string  `protobuf:"bytes,6,opt,name=period,proto3" json:"period,omitempty"`
+	StartDate   string  `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD
+	EndDate     string  `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive
+	Value       float64 `protobuf:"fixed64,9,opt,name=value,proto3" json:"value,omitempty"`
+	Target      float64 `protobuf:"fixed64,10,opt,name=target,proto3" json:"target,omitempty"`
+	Streak      int32   `protobuf:"varint,11,opt,name=streak,proto3" json:"streak,omitempty"`                             // Achieved periods in a row ending with this one
+	EvaluatedAt string  `protobuf:"bytes,12,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"` // RFC3339 format
+}
+
+func (x *HealthGoalEvent) Reset() {
+	*x = HealthGoalEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -770,13 +899,13 @@ func (x *ListMedicalRecordsRequest) Reset() {
 	}
 }
 
-func (x *ListMedicalRecordsRequest) String() string {
+func (x *HealthGoalEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMedicalRecordsRequest) ProtoMessage() {}
+func (*HealthGoalEvent) ProtoMessage() {}
 
-func (x *ListMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
+func (x *HealthGoalEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,58 +917,109 @@ func (x *ListMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMedicalRecordsRequest.ProtoReflect.Descriptor instead.
-func (*ListMedicalRecordsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthGoalEvent.ProtoReflect.Descriptor instead.
+func (*HealthGoalEvent) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListMedicalRecordsRequest) GetUserId() string {
+func (x *HealthGoalEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HealthGoalEvent) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *HealthGoalEvent) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListMedicalRecordsRequest) GetRecordType() string {
+func (x *HealthGoalEvent) GetOutcome() string {
 	if x != nil {
-		return x.RecordType
+		return x.Outcome
 	}
 	return ""
 }
 
-func (x *ListMedicalRecordsRequest) GetRecordDate() string {
+func (x *HealthGoalEvent) GetDataType() string {
 	if x != nil {
-		return x.RecordDate
+		return x.DataType
 	}
 	return ""
 }
 
-func (x *ListMedicalRecordsRequest) GetDescription() string {
+func (x *HealthGoalEvent) GetPeriod() string {
 	if x != nil {
-		return x.Description
+		return x.Period
 	}
 	return ""
 }
 
-func (x *ListMedicalRecordsRequest) GetDoctorId() string {
+func (x *HealthGoalEvent) GetStartDate() string {
 	if x != nil {
-		return x.DoctorId
+		return x.StartDate
 	}
 	return ""
 }
 
-type ListGeneticDataRequest struct {
+func (x *HealthGoalEvent) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *HealthGoalEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HealthGoalEvent) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *HealthGoalEvent) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *HealthGoalEvent) GetEvaluatedAt() string {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return ""
+}
+
+// Sleep Data
+type SleepData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DataType     string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	AnalysisDate string `protobuf:"bytes,3,opt,name=analysis_date,json=analysisDate,proto3" json:"analysis_date,omitempty"`
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SleepDuration int64  `protobuf:"varint,2,opt,name=sleep_duration,json=sleepDuration,proto3" json:"sleep_duration,omitempty"` // Sleep duration in milliseconds
+	SleepQuality  string `protobuf:"bytes,3,opt,name=sleep_quality,json=sleepQuality,proto3" json:"sleep_quality,omitempty"`     // Subjective sleep quality (e.g., "Good", "Average", "Poor")
+	RecordedDate  string `protobuf:"bytes,4,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`     // Date when the sleep data was recorded (YYYY-MM-DD)
 }
 
-func (x *ListGeneticDataRequest) Reset() {
-	*x = ListGeneticDataRequest{}
+func (x *SleepData) Reset() {
+	*x = SleepData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,13 +1027,13 @@ func (x *ListGeneticDataRequest) Reset() {
 	}
 }
 
-func (x *ListGeneticDataRequest) String() string {
+func (x *SleepData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGeneticDataRequest) ProtoMessage() {}
+func (*SleepData) ProtoMessage() {}
 
-func (x *ListGeneticDataRequest) ProtoReflect() protoreflect.Message {
+func (x *SleepData) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -865,44 +1045,52 @@ func (x *ListGeneticDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGeneticDataRequest.ProtoReflect.Descriptor instead.
-func (*ListGeneticDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SleepData.ProtoReflect.Descriptor instead.
+func (*SleepData) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListGeneticDataRequest) GetUserId() string {
+func (x *SleepData) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListGeneticDataRequest) GetDataType() string {
+func (x *SleepData) GetSleepDuration() int64 {
 	if x != nil {
-		return x.DataType
+		return x.SleepDuration
+	}
+	return 0
+}
+
+func (x *SleepData) GetSleepQuality() string {
+	if x != nil {
+		return x.SleepQuality
 	}
 	return ""
 }
 
-func (x *ListGeneticDataRequest) GetAnalysisDate() string {
+func (x *SleepData) GetRecordedDate() string {
 	if x != nil {
-		return x.AnalysisDate
+		return x.RecordedDate
 	}
 	return ""
 }
 
-type ListLifestyleDataRequest struct {
+// Heart Rate Data
+type HeartRateData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DataType     string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	RecordedDate string `protobuf:"bytes,3,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
+	UserId            string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HeartRate         int32  `protobuf:"varint,2,opt,name=heart_rate,json=heartRate,proto3" json:"heart_rate,omitempty"`                        // Heart rate in beats per minute (BPM)
+	RecordedTimestamp string `protobuf:"bytes,3,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"` // Timestamp when the heart rate was recorded (RFC3339 format)
 }
 
-func (x *ListLifestyleDataRequest) Reset() {
-	*x = ListLifestyleDataRequest{}
+func (x *HeartRateData) Reset() {
+	*x = HeartRateData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,13 +1098,13 @@ func (x *ListLifestyleDataRequest) Reset() {
 	}
 }
 
-func (x *ListLifestyleDataRequest) String() string {
+func (x *HeartRateData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLifestyleDataRequest) ProtoMessage() {}
+func (*HeartRateData) ProtoMessage() {}
 
-func (x *ListLifestyleDataRequest) ProtoReflect() protoreflect.Message {
+func (x *HeartRateData) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -928,45 +1116,44 @@ func (x *ListLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLifestyleDataRequest.ProtoReflect.Descriptor instead.
-func (*ListLifestyleDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HeartRateData.ProtoReflect.Descriptor instead.
+func (*HeartRateData) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListLifestyleDataRequest) GetUserId() string {
+func (x *HeartRateData) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListLifestyleDataRequest) GetDataType() string {
+func (x *HeartRateData) GetHeartRate() int32 {
 	if x != nil {
-		return x.DataType
+		return x.HeartRate
 	}
-	return ""
+	return 0
 }
 
-func (x *ListLifestyleDataRequest) GetRecordedDate() string {
+func (x *HeartRateData) GetRecordedTimestamp() string {
 	if x != nil {
-		return x.RecordedDate
+		return x.RecordedTimestamp
 	}
 	return ""
 }
 
-type ListWearableDataRequest struct {
+// Typed series returned by the sleep and heart rate endpoints
+type SleepSeries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId            string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeviceType        string `protobuf:"bytes,2,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	DataType          string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	RecordedTimestamp string `protobuf:"bytes,4,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
+	SleepData     []*SleepData `protobuf:"bytes,1,rep,name=sleep_data,json=sleepData,proto3" json:"sleep_data,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 }
 
-func (x *ListWearableDataRequest) Reset() {
-	*x = ListWearableDataRequest{}
+func (x *SleepSeries) Reset() {
+	*x = SleepSeries{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -974,13 +1161,13 @@ func (x *ListWearableDataRequest) Reset() {
 	}
 }
 
-func (x *ListWearableDataRequest) String() string {
+func (x *SleepSeries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWearableDataRequest) ProtoMessage() {}
+func (*SleepSeries) ProtoMessage() {}
 
-func (x *ListWearableDataRequest) ProtoReflect() protoreflect.Message {
+func (x *SleepSeries) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -992,51 +1179,36 @@ func (x *ListWearableDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWearableDataRequest.ProtoReflect.Descriptor instead.
-func (*ListWearableDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SleepSeries.ProtoReflect.Descriptor instead.
+func (*SleepSeries) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListWearableDataRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *ListWearableDataRequest) GetDeviceType() string {
+func (x *SleepSeries) GetSleepData() []*SleepData {
 	if x != nil {
-		return x.DeviceType
-	}
-	return ""
-}
-
-func (x *ListWearableDataRequest) GetDataType() string {
-	if x != nil {
-		return x.DataType
+		return x.SleepData
 	}
-	return ""
+	return nil
 }
 
-func (x *ListWearableDataRequest) GetRecordedTimestamp() string {
+func (x *SleepSeries) GetNextPageToken() string {
 	if x != nil {
-		return x.RecordedTimestamp
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type ListHealthRecommendationsRequest struct {
+type HeartRateSeries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId             string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RecommendationType string `protobuf:"bytes,2,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
-	Priority           int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	HeartRateData []*HeartRateData `protobuf:"bytes,1,rep,name=heart_rate_data,json=heartRateData,proto3" json:"heart_rate_data,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 }
 
-func (x *ListHealthRecommendationsRequest) Reset() {
-	*x = ListHealthRecommendationsRequest{}
+func (x *HeartRateSeries) Reset() {
+	*x = HeartRateSeries{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1044,13 +1216,13 @@ func (x *ListHealthRecommendationsRequest) Reset() {
 	}
 }
 
-func (x *ListHealthRecommendationsRequest) String() string {
+func (x *HeartRateSeries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHealthRecommendationsRequest) ProtoMessage() {}
+func (*HeartRateSeries) ProtoMessage() {}
 
-func (x *ListHealthRecommendationsRequest) ProtoReflect() protoreflect.Message {
+func (x *HeartRateSeries) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1062,43 +1234,42 @@ func (x *ListHealthRecommendationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHealthRecommendationsRequest.ProtoReflect.Descriptor instead.
-func (*ListHealthRecommendationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HeartRateSeries.ProtoReflect.Descriptor instead.
+func (*HeartRateSeries) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListHealthRecommendationsRequest) GetUserId() string {
+func (x *HeartRateSeries) GetHeartRateData() []*HeartRateData {
 	if x != nil {
-		return x.UserId
+		return x.HeartRateData
 	}
-	return ""
+	return nil
 }
 
-func (x *ListHealthRecommendationsRequest) GetRecommendationType() string {
+func (x *HeartRateSeries) GetNextPageToken() string {
 	if x != nil {
-		return x.RecommendationType
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ListHealthRecommendationsRequest) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-// Response messages for List methods
-type ListMedicalRecordsResponse struct {
+// Time-series rollup of wearable data. Only the requested functions are set.
+type WearableDataBucket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MedicalRecords []*MedicalRecord `protobuf:"bytes,1,rep,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+	Start       string             `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // Start of the bucket, inclusive (RFC3339 format, in the requested time zone)
+	End         string             `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // End of the bucket, exclusive (RFC3339 format, in the requested time zone)
+	Count       *int64             `protobuf:"varint,3,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Min         *float64           `protobuf:"fixed64,4,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max         *float64           `protobuf:"fixed64,5,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Avg         *float64           `protobuf:"fixed64,6,opt,name=avg,proto3,oneof" json:"avg,omitempty"`
+	Percentiles map[string]float64 `protobuf:"bytes,7,rep,name=percentiles,proto3" json:"percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"` // Keyed by function, e.g. "p95"
 }
 
-func (x *ListMedicalRecordsResponse) Reset() {
-	*x = ListMedicalRecordsResponse{}
+func (x *WearableDataBucket) Reset() {
+	*x = WearableDataBucket{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1106,13 +1277,13 @@ func (x *ListMedicalRecordsResponse) Reset() {
 	}
 }
 
-func (x *ListMedicalRecordsResponse) String() string {
+func (x *WearableDataBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMedicalRecordsResponse) ProtoMessage() {}
+func (*WearableDataBucket) ProtoMessage() {}
 
-func (x *ListMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
+func (x *WearableDataBucket) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,28 +1295,74 @@ func (x *ListMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMedicalRecordsResponse.ProtoReflect.Descriptor instead.
-func (*ListMedicalRecordsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WearableDataBucket.ProtoReflect.Descriptor instead.
+func (*WearableDataBucket) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListMedicalRecordsResponse) GetMedicalRecords() []*MedicalRecord {
+func (x *WearableDataBucket) GetStart() string {
 	if x != nil {
-		return x.MedicalRecords
+		return x.Start
+	}
+	return ""
+}
+
+func (x *WearableDataBucket) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *WearableDataBucket) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *WearableDataBucket) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *WearableDataBucket) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *WearableDataBucket) GetAvg() float64 {
+	if x != nil && x.Avg != nil {
+		return *x.Avg
+	}
+	return 0
+}
+
+func (x *WearableDataBucket) GetPercentiles() map[string]float64 {
+	if x != nil {
+		return x.Percentiles
 	}
 	return nil
 }
 
-type ListGeneticDataResponse struct {
+type WearableDataAggregate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GeneticData []*GeneticData `protobuf:"bytes,1,rep,name=genetic_data,json=geneticData,proto3" json:"genetic_data,omitempty"`
+	UserId   string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType string                `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Bucket   string                `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`                     // minute, hour or day
+	TimeZone string                `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA time zone the buckets are aligned to
+	Buckets  []*WearableDataBucket `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`                   // Buckets without samples are omitted
 }
 
-func (x *ListGeneticDataResponse) Reset() {
-	*x = ListGeneticDataResponse{}
+func (x *WearableDataAggregate) Reset() {
+	*x = WearableDataAggregate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1153,13 +1370,13 @@ func (x *ListGeneticDataResponse) Reset() {
 	}
 }
 
-func (x *ListGeneticDataResponse) String() string {
+func (x *WearableDataAggregate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGeneticDataResponse) ProtoMessage() {}
+func (*WearableDataAggregate) ProtoMessage() {}
 
-func (x *ListGeneticDataResponse) ProtoReflect() protoreflect.Message {
+func (x *WearableDataAggregate) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1171,28 +1388,67 @@ func (x *ListGeneticDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGeneticDataResponse.ProtoReflect.Descriptor instead.
-func (*ListGeneticDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WearableDataAggregate.ProtoReflect.Descriptor instead.
+func (*WearableDataAggregate) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListGeneticDataResponse) GetGeneticData() []*GeneticData {
+func (x *WearableDataAggregate) GetUserId() string {
 	if x != nil {
-		return x.GeneticData
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WearableDataAggregate) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *WearableDataAggregate) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *WearableDataAggregate) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *WearableDataAggregate) GetBuckets() []*WearableDataBucket {
+	if x != nil {
+		return x.Buckets
 	}
 	return nil
 }
 
-type ListLifestyleDataResponse struct {
+// Alert raised by the wearable anomaly rules on an ingested sample
+type WearableAlert struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LifestyleData []*LifestyleData `protobuf:"bytes,1,rep,name=lifestyle_data,json=lifestyleData,proto3" json:"lifestyle_data,omitempty"`
-}
-
-func (x *ListLifestyleDataResponse) Reset() {
-	*x = ListLifestyleDataResponse{}
+	Id                string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            string  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rule              string  `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"` // Name of the rule that matched
+	Severity          string  `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	DataType          string  `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DeviceType        string  `protobuf:"bytes,6,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	Value             float64 `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`         // Value of the sample
+	Threshold         float64 `protobuf:"fixed64,8,opt,name=threshold,proto3" json:"threshold,omitempty"` // Threshold, or minimum change, of the rule
+	Message           string  `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	RecordedTimestamp string  `protobuf:"bytes,10,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"` // Timestamp of the sample (RFC3339 format)
+	TriggeredAt       string  `protobuf:"bytes,11,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`                   // Timestamp the alert was raised (RFC3339 format)
+}
+
+func (x *WearableAlert) Reset() {
+	*x = WearableAlert{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,13 +1456,13 @@ func (x *ListLifestyleDataResponse) Reset() {
 	}
 }
 
-func (x *ListLifestyleDataResponse) String() string {
+func (x *WearableAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLifestyleDataResponse) ProtoMessage() {}
+func (*WearableAlert) ProtoMessage() {}
 
-func (x *ListLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+func (x *WearableAlert) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1218,28 +1474,97 @@ func (x *ListLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLifestyleDataResponse.ProtoReflect.Descriptor instead.
-func (*ListLifestyleDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WearableAlert.ProtoReflect.Descriptor instead.
+func (*WearableAlert) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListLifestyleDataResponse) GetLifestyleData() []*LifestyleData {
+func (x *WearableAlert) GetId() string {
 	if x != nil {
-		return x.LifestyleData
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListWearableDataResponse struct {
+func (x *WearableAlert) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WearableAlert) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *WearableAlert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *WearableAlert) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *WearableAlert) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *WearableAlert) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *WearableAlert) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *WearableAlert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WearableAlert) GetRecordedTimestamp() string {
+	if x != nil {
+		return x.RecordedTimestamp
+	}
+	return ""
+}
+
+func (x *WearableAlert) GetTriggeredAt() string {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return ""
+}
+
+// Empty Message
+type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	WearableData []*WearableData `protobuf:"bytes,1,rep,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
 }
 
-func (x *ListWearableDataResponse) Reset() {
-	*x = ListWearableDataResponse{}
+func (x *Empty) Reset() {
+	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1247,13 +1572,13 @@ func (x *ListWearableDataResponse) Reset() {
 	}
 }
 
-func (x *ListWearableDataResponse) String() string {
+func (x *Empty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWearableDataResponse) ProtoMessage() {}
+func (*Empty) ProtoMessage() {}
 
-func (x *ListWearableDataResponse) ProtoReflect() protoreflect.Message {
+func (x *Empty) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1265,28 +1590,24 @@ func (x *ListWearableDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWearableDataResponse.ProtoReflect.Descriptor instead.
-func (*ListWearableDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListWearableDataResponse) GetWearableData() []*WearableData {
-	if x != nil {
-		return x.WearableData
-	}
-	return nil
-}
-
-type ListHealthRecommendationsResponse struct {
+// Partial updates published for PATCH requests. Only the fields listed in
+// update_mask are meaningful; listed fields left unset are cleared.
+type MedicalRecordPatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HealthRecommendations []*HealthRecommendation `protobuf:"bytes,1,rep,name=health_recommendations,json=healthRecommendations,proto3" json:"health_recommendations,omitempty"`
+	MedicalRecord *MedicalRecord         `protobuf:"bytes,1,opt,name=medical_record,json=medicalRecord,proto3" json:"medical_record,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *ListHealthRecommendationsResponse) Reset() {
-	*x = ListHealthRecommendationsResponse{}
+func (x *MedicalRecordPatch) Reset() {
+	*x = MedicalRecordPatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,13 +1615,13 @@ func (x *ListHealthRecommendationsResponse) Reset() {
 	}
 }
 
-func (x *ListHealthRecommendationsResponse) String() string {
+func (x *MedicalRecordPatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHealthRecommendationsResponse) ProtoMessage() {}
+func (*MedicalRecordPatch) ProtoMessage() {}
 
-func (x *ListHealthRecommendationsResponse) ProtoReflect() protoreflect.Message {
+func (x *MedicalRecordPatch) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,30 +1633,36 @@ func (x *ListHealthRecommendationsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHealthRecommendationsResponse.ProtoReflect.Descriptor instead.
-func (*ListHealthRecommendationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MedicalRecordPatch.ProtoReflect.Descriptor instead.
+func (*MedicalRecordPatch) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListHealthRecommendationsResponse) GetHealthRecommendations() []*HealthRecommendation {
+func (x *MedicalRecordPatch) GetMedicalRecord() *MedicalRecord {
 	if x != nil {
-		return x.HealthRecommendations
+		return x.MedicalRecord
 	}
 	return nil
 }
 
-// DailySummaryRequest message
-type DailySummaryRequest struct {
+func (x *MedicalRecordPatch) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type GeneticDataPatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Date   string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // Date in YYYY-MM-DD format
+	GeneticData *GeneticData           `protobuf:"bytes,1,opt,name=genetic_data,json=geneticData,proto3" json:"genetic_data,omitempty"`
+	UpdateMask  *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *DailySummaryRequest) Reset() {
-	*x = DailySummaryRequest{}
+func (x *GeneticDataPatch) Reset() {
+	*x = GeneticDataPatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_medical_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,13 +1670,13 @@ func (x *DailySummaryRequest) Reset() {
 	}
 }
 
-func (x *DailySummaryRequest) String() string {
+func (x *GeneticDataPatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DailySummaryRequest) ProtoMessage() {}
+func (*GeneticDataPatch) ProtoMessage() {}
 
-func (x *DailySummaryRequest) ProtoReflect() protoreflect.Message {
+func (x *GeneticDataPatch) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_medical_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1361,16 +1688,1242 @@ func (x *DailySummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DailySummaryRequest.ProtoReflect.Descriptor instead.
-func (*DailySummaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeneticDataPatch.ProtoReflect.Descriptor instead.
+func (*GeneticDataPatch) Descriptor() ([]byte, []int) {
 	return file_protos_medical_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DailySummaryRequest) GetUserId() string {
+func (x *GeneticDataPatch) GetGeneticData() *GeneticData {
 	if x != nil {
-		return x.UserId
+		return x.GeneticData
 	}
-	return ""
+	return nil
+}
+
+func (x *GeneticDataPatch) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type LifestyleDataPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LifestyleData *LifestyleData         `protobuf:"bytes,1,opt,name=lifestyle_data,json=lifestyleData,proto3" json:"lifestyle_data,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *LifestyleDataPatch) Reset() {
+	*x = LifestyleDataPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LifestyleDataPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifestyleDataPatch) ProtoMessage() {}
+
+func (x *LifestyleDataPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifestyleDataPatch.ProtoReflect.Descriptor instead.
+func (*LifestyleDataPatch) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LifestyleDataPatch) GetLifestyleData() *LifestyleData {
+	if x != nil {
+		return x.LifestyleData
+	}
+	return nil
+}
+
+func (x *LifestyleDataPatch) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type WearableDataPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WearableData *WearableData          `protobuf:"bytes,1,opt,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
+	UpdateMask   *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *WearableDataPatch) Reset() {
+	*x = WearableDataPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WearableDataPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WearableDataPatch) ProtoMessage() {}
+
+func (x *WearableDataPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WearableDataPatch.ProtoReflect.Descriptor instead.
+func (*WearableDataPatch) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WearableDataPatch) GetWearableData() *WearableData {
+	if x != nil {
+		return x.WearableData
+	}
+	return nil
+}
+
+func (x *WearableDataPatch) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type HealthRecommendationPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HealthRecommendation *HealthRecommendation  `protobuf:"bytes,1,opt,name=health_recommendation,json=healthRecommendation,proto3" json:"health_recommendation,omitempty"`
+	UpdateMask           *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *HealthRecommendationPatch) Reset() {
+	*x = HealthRecommendationPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthRecommendationPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRecommendationPatch) ProtoMessage() {}
+
+func (x *HealthRecommendationPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRecommendationPatch.ProtoReflect.Descriptor instead.
+func (*HealthRecommendationPatch) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HealthRecommendationPatch) GetHealthRecommendation() *HealthRecommendation {
+	if x != nil {
+		return x.HealthRecommendation
+	}
+	return nil
+}
+
+func (x *HealthRecommendationPatch) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type HealthGoalPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HealthGoal *HealthGoal            `protobuf:"bytes,1,opt,name=health_goal,json=healthGoal,proto3" json:"health_goal,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *HealthGoalPatch) Reset() {
+	*x = HealthGoalPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthGoalPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthGoalPatch) ProtoMessage() {}
+
+func (x *HealthGoalPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthGoalPatch.ProtoReflect.Descriptor instead.
+func (*HealthGoalPatch) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HealthGoalPatch) GetHealthGoal() *HealthGoal {
+	if x != nil {
+		return x.HealthGoal
+	}
+	return nil
+}
+
+func (x *HealthGoalPatch) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Request messages for List methods with filters
+type ListMedicalRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecordType     string `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	RecordDate     string `protobuf:"bytes,3,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	Description    string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DoctorId       string `protobuf:"bytes,5,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	RecordDateFrom string `protobuf:"bytes,6,opt,name=record_date_from,json=recordDateFrom,proto3" json:"record_date_from,omitempty"` // Inclusive lower bound (YYYY-MM-DD)
+	RecordDateTo   string `protobuf:"bytes,7,opt,name=record_date_to,json=recordDateTo,proto3" json:"record_date_to,omitempty"`       // Inclusive upper bound (YYYY-MM-DD)
+	PageSize       int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                   // Maximum number of items to return
+	PageToken      string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                 // next_page_token of the previous page
+	OrderBy        string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                       // Comma separated fields, each optionally followed by " desc"
+}
+
+func (x *ListMedicalRecordsRequest) Reset() {
+	*x = ListMedicalRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMedicalRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsRequest) ProtoMessage() {}
+
+func (x *ListMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListMedicalRecordsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetRecordDate() string {
+	if x != nil {
+		return x.RecordDate
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetRecordDateFrom() string {
+	if x != nil {
+		return x.RecordDateFrom
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetRecordDateTo() string {
+	if x != nil {
+		return x.RecordDateTo
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMedicalRecordsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListGeneticDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType         string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	AnalysisDate     string `protobuf:"bytes,3,opt,name=analysis_date,json=analysisDate,proto3" json:"analysis_date,omitempty"`
+	AnalysisDateFrom string `protobuf:"bytes,4,opt,name=analysis_date_from,json=analysisDateFrom,proto3" json:"analysis_date_from,omitempty"` // Inclusive lower bound (YYYY-MM-DD)
+	AnalysisDateTo   string `protobuf:"bytes,5,opt,name=analysis_date_to,json=analysisDateTo,proto3" json:"analysis_date_to,omitempty"`       // Inclusive upper bound (YYYY-MM-DD)
+	PageSize         int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                         // Maximum number of items to return
+	PageToken        string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                       // next_page_token of the previous page
+	OrderBy          string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                             // Comma separated fields, each optionally followed by " desc"
+}
+
+func (x *ListGeneticDataRequest) Reset() {
+	*x = ListGeneticDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGeneticDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeneticDataRequest) ProtoMessage() {}
+
+func (x *ListGeneticDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeneticDataRequest.ProtoReflect.Descriptor instead.
+func (*ListGeneticDataRequest) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListGeneticDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListGeneticDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *ListGeneticDataRequest) GetAnalysisDate() string {
+	if x != nil {
+		return x.AnalysisDate
+	}
+	return ""
+}
+
+func (x *ListGeneticDataRequest) GetAnalysisDateFrom() string {
+	if x != nil {
+		return x.AnalysisDateFrom
+	}
+	return ""
+}
+
+func (x *ListGeneticDataRequest) GetAnalysisDateTo() string {
+	if x != nil {
+		return x.AnalysisDateTo
+	}
+	return ""
+}
+
+func (x *ListGeneticDataRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGeneticDataRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListGeneticDataRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListLifestyleDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType         string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	RecordedDate     string `protobuf:"bytes,3,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
+	RecordedDateFrom string `protobuf:"bytes,4,opt,name=recorded_date_from,json=recordedDateFrom,proto3" json:"recorded_date_from,omitempty"` // Inclusive lower bound (YYYY-MM-DD)
+	RecordedDateTo   string `protobuf:"bytes,5,opt,name=recorded_date_to,json=recordedDateTo,proto3" json:"recorded_date_to,omitempty"`       // Inclusive upper bound (YYYY-MM-DD)
+	PageSize         int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                         // Maximum number of items to return
+	PageToken        string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                       // next_page_token of the previous page
+	OrderBy          string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                             // Comma separated fields, each optionally followed by " desc"
+}
+
+func (x *ListLifestyleDataRequest) Reset() {
+	*x = ListLifestyleDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLifestyleDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLifestyleDataRequest) ProtoMessage() {}
+
+func (x *ListLifestyleDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLifestyleDataRequest.ProtoReflect.Descriptor instead.
+func (*ListLifestyleDataRequest) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListLifestyleDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListLifestyleDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *ListLifestyleDataRequest) GetRecordedDate() string {
+	if x != nil {
+		return x.RecordedDate
+	}
+	return ""
+}
+
+func (x *ListLifestyleDataRequest) GetRecordedDateFrom() string {
+	if x != nil {
+		return x.RecordedDateFrom
+	}
+	return ""
+}
+
+func (x *ListLifestyleDataRequest) GetRecordedDateTo() string {
+	if x != nil {
+		return x.RecordedDateTo
+	}
+	return ""
+}
+
+func (x *ListLifestyleDataRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLifestyleDataRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLifestyleDataRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListWearableDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId                string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceType            string `protobuf:"bytes,2,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	DataType              string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	RecordedTimestamp     string `protobuf:"bytes,4,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
+	RecordedTimestampFrom string `protobuf:"bytes,5,opt,name=recorded_timestamp_from,json=recordedTimestampFrom,proto3" json:"recorded_timestamp_from,omitempty"` // Inclusive lower bound (RFC3339 format, UTC)
+	RecordedTimestampTo   string `protobuf:"bytes,6,opt,name=recorded_timestamp_to,json=recordedTimestampTo,proto3" json:"recorded_timestamp_to,omitempty"`       // Inclusive upper bound (RFC3339 format, UTC)
+	PageSize              int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                        // Maximum number of items to return
+	PageToken             string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                      // next_page_token of the previous page
+	OrderBy               string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                                            // Comma separated fields, each optionally followed by " desc"
+}
+
+func (x *ListWearableDataRequest) Reset() {
+	*x = ListWearableDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWearableDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWearableDataRequest) ProtoMessage() {}
+
+func (x *ListWearableDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWearableDataRequest.ProtoReflect.Descriptor instead.
+func (*ListWearableDataRequest) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListWearableDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWearableDataRequest) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *ListWearableDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *ListWearableDataRequest) GetRecordedTimestamp() string {
+	if x != nil {
+		return x.RecordedTimestamp
+	}
+	return ""
+}
+
+func (x *ListWearableDataRequest) GetRecordedTimestampFrom() string {
+	if x != nil {
+		return x.RecordedTimestampFrom
+	}
+	return ""
+}
+
+func (x *ListWearableDataRequest) GetRecordedTimestampTo() string {
+	if x != nil {
+		return x.RecordedTimestampTo
+	}
+	return ""
+}
+
+func (x *ListWearableDataRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWearableDataRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWearableDataRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListHealthRecommendationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId             string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecommendationType string `protobuf:"bytes,2,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
+	Priority           int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	PageSize           int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Maximum number of items to return
+	PageToken          string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy            string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // Comma separated fields, each optionally followed by " desc"
+}
+
+func (x *ListHealthRecommendationsRequest) Reset() {
+	*x = ListHealthRecommendationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHealthRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHealthRecommendationsRequest) ProtoMessage() {}
+
+func (x *ListHealthRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHealthRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*ListHealthRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListHealthRecommendationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListHealthRecommendationsRequest) GetRecommendationType() string {
+	if x != nil {
+		return x.RecommendationType
+	}
+	return ""
+}
+
+func (x *ListHealthRecommendationsRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ListHealthRecommendationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHealthRecommendationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListHealthRecommendationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListHealthGoalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source    string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	DataType  string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Period    string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	PageSize  int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Maximum number of items to return
+	PageToken string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy   string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // Comma separated fields, each optionally followed by " desc"
+}
+
+func (x *ListHealthGoalsRequest) Reset() {
+	*x = ListHealthGoalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHealthGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHealthGoalsRequest) ProtoMessage() {}
+
+func (x *ListHealthGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHealthGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListHealthGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListHealthGoalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListHealthGoalsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ListHealthGoalsRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *ListHealthGoalsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ListHealthGoalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHealthGoalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListHealthGoalsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// Response messages for List methods
+type ListMedicalRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MedicalRecords []*MedicalRecord `protobuf:"bytes,1,rep,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+	NextPageToken  string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *ListMedicalRecordsResponse) Reset() {
+	*x = ListMedicalRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMedicalRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsResponse) ProtoMessage() {}
+
+func (x *ListMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMedicalRecordsResponse) GetMedicalRecords() []*MedicalRecord {
+	if x != nil {
+		return x.MedicalRecords
+	}
+	return nil
+}
+
+func (x *ListMedicalRecordsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListGeneticDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GeneticData   []*GeneticData `protobuf:"bytes,1,rep,name=genetic_data,json=geneticData,proto3" json:"genetic_data,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *ListGeneticDataResponse) Reset() {
+	*x = ListGeneticDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGeneticDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeneticDataResponse) ProtoMessage() {}
+
+func (x *ListGeneticDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeneticDataResponse.ProtoReflect.Descriptor instead.
+func (*ListGeneticDataResponse) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListGeneticDataResponse) GetGeneticData() []*GeneticData {
+	if x != nil {
+		return x.GeneticData
+	}
+	return nil
+}
+
+func (x *ListGeneticDataResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListLifestyleDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LifestyleData []*LifestyleData `protobuf:"bytes,1,rep,name=lifestyle_data,json=lifestyleData,proto3" json:"lifestyle_data,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *ListLifestyleDataResponse) Reset() {
+	*x = ListLifestyleDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLifestyleDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLifestyleDataResponse) ProtoMessage() {}
+
+func (x *ListLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLifestyleDataResponse.ProtoReflect.Descriptor instead.
+func (*ListLifestyleDataResponse) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListLifestyleDataResponse) GetLifestyleData() []*LifestyleData {
+	if x != nil {
+		return x.LifestyleData
+	}
+	return nil
+}
+
+func (x *ListLifestyleDataResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListWearableDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WearableData  []*WearableData `protobuf:"bytes,1,rep,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *ListWearableDataResponse) Reset() {
+	*x = ListWearableDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWearableDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWearableDataResponse) ProtoMessage() {}
+
+func (x *ListWearableDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWearableDataResponse.ProtoReflect.Descriptor instead.
+func (*ListWearableDataResponse) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListWearableDataResponse) GetWearableData() []*WearableData {
+	if x != nil {
+		return x.WearableData
+	}
+	return nil
+}
+
+func (x *ListWearableDataResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListHealthRecommendationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HealthRecommendations []*HealthRecommendation `protobuf:"bytes,1,rep,name=health_recommendations,json=healthRecommendations,proto3" json:"health_recommendations,omitempty"`
+	NextPageToken         string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *ListHealthRecommendationsResponse) Reset() {
+	*x = ListHealthRecommendationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHealthRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHealthRecommendationsResponse) ProtoMessage() {}
+
+func (x *ListHealthRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHealthRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*ListHealthRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListHealthRecommendationsResponse) GetHealthRecommendations() []*HealthRecommendation {
+	if x != nil {
+		return x.HealthRecommendations
+	}
+	return nil
+}
+
+func (x *ListHealthRecommendationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListHealthGoalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HealthGoals   []*HealthGoal `protobuf:"bytes,1,rep,name=health_goals,json=healthGoals,proto3" json:"health_goals,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *ListHealthGoalsResponse) Reset() {
+	*x = ListHealthGoalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHealthGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHealthGoalsResponse) ProtoMessage() {}
+
+func (x *ListHealthGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHealthGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListHealthGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListHealthGoalsResponse) GetHealthGoals() []*HealthGoal {
+	if x != nil {
+		return x.HealthGoals
+	}
+	return nil
+}
+
+func (x *ListHealthGoalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// DailySummaryRequest message
+type DailySummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date   string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // Date in YYYY-MM-DD format
+}
+
+func (x *DailySummaryRequest) Reset() {
+	*x = DailySummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailySummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailySummaryRequest) ProtoMessage() {}
+
+func (x *DailySummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailySummaryRequest.ProtoReflect.Descriptor instead.
+func (*DailySummaryRequest) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DailySummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
 func (x *DailySummaryRequest) GetDate() string {
@@ -1394,7 +2947,7 @@ type WeeklySummaryRequest struct {
 func (x *WeeklySummaryRequest) Reset() {
 	*x = WeeklySummaryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_medical_proto_msgTypes[20]
+		mi := &file_protos_medical_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1407,7 +2960,7 @@ func (x *WeeklySummaryRequest) String() string {
 func (*WeeklySummaryRequest) ProtoMessage() {}
 
 func (x *WeeklySummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_medical_proto_msgTypes[20]
+	mi := &file_protos_medical_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,7 +2973,7 @@ func (x *WeeklySummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklySummaryRequest.ProtoReflect.Descriptor instead.
 func (*WeeklySummaryRequest) Descriptor() ([]byte, []int) {
-	return file_protos_medical_proto_rawDescGZIP(), []int{20}
+	return file_protos_medical_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *WeeklySummaryRequest) GetUserId() string {
@@ -1460,7 +3013,7 @@ type SummaryResponse struct {
 func (x *SummaryResponse) Reset() {
 	*x = SummaryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_medical_proto_msgTypes[21]
+		mi := &file_protos_medical_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1473,7 +3026,7 @@ func (x *SummaryResponse) String() string {
 func (*SummaryResponse) ProtoMessage() {}
 
 func (x *SummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_medical_proto_msgTypes[21]
+	mi := &file_protos_medical_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1486,7 +3039,7 @@ func (x *SummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryResponse.ProtoReflect.Descriptor instead.
 func (*SummaryResponse) Descriptor() ([]byte, []int) {
-	return file_protos_medical_proto_rawDescGZIP(), []int{21}
+	return file_protos_medical_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *SummaryResponse) GetMedicalRecords() []*MedicalRecord {
@@ -1524,348 +3077,1058 @@ func (x *SummaryResponse) GetHealthRecommendations() []*HealthRecommendation {
 	return nil
 }
 
+// Monthly and custom range summaries
+type SummaryReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate  string             `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Start date in YYYY-MM-DD format, inclusive
+	EndDate    string             `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // End date in YYYY-MM-DD format, inclusive
+	Statistics *SummaryStatistics `protobuf:"bytes,4,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Records    *SummaryResponse   `protobuf:"bytes,5,opt,name=records,proto3" json:"records,omitempty"` // Only set when the records are requested
+}
+
+func (x *SummaryReport) Reset() {
+	*x = SummaryReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SummaryReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummaryReport) ProtoMessage() {}
+
+func (x *SummaryReport) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummaryReport.ProtoReflect.Descriptor instead.
+func (*SummaryReport) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SummaryReport) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SummaryReport) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SummaryReport) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SummaryReport) GetStatistics() *SummaryStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *SummaryReport) GetRecords() *SummaryResponse {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// Statistics derived from the records of a summary
+type SummaryStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts              *EntityCounts       `protobuf:"bytes,1,opt,name=counts,proto3" json:"counts,omitempty"`
+	MedicalRecordTypes  map[string]int32    `protobuf:"bytes,2,rep,name=medical_record_types,json=medicalRecordTypes,proto3" json:"medical_record_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`  // Medical records per record_type
+	GeneticDataTypes    map[string]int32    `protobuf:"bytes,3,rep,name=genetic_data_types,json=geneticDataTypes,proto3" json:"genetic_data_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`        // Genetic data per data_type
+	LifestyleMetrics    []*MetricStatistics `protobuf:"bytes,4,rep,name=lifestyle_metrics,json=lifestyleMetrics,proto3" json:"lifestyle_metrics,omitempty"`                                                                                                   // Per data_type, numeric values only
+	WearableMetrics     []*MetricStatistics `protobuf:"bytes,5,rep,name=wearable_metrics,json=wearableMetrics,proto3" json:"wearable_metrics,omitempty"`                                                                                                      // Per data_type, numeric values only
+	RecommendationTypes map[string]int32    `protobuf:"bytes,6,rep,name=recommendation_types,json=recommendationTypes,proto3" json:"recommendation_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Recommendations per recommendation_type
+}
+
+func (x *SummaryStatistics) Reset() {
+	*x = SummaryStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SummaryStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummaryStatistics) ProtoMessage() {}
+
+func (x *SummaryStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummaryStatistics.ProtoReflect.Descriptor instead.
+func (*SummaryStatistics) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SummaryStatistics) GetCounts() *EntityCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *SummaryStatistics) GetMedicalRecordTypes() map[string]int32 {
+	if x != nil {
+		return x.MedicalRecordTypes
+	}
+	return nil
+}
+
+func (x *SummaryStatistics) GetGeneticDataTypes() map[string]int32 {
+	if x != nil {
+		return x.GeneticDataTypes
+	}
+	return nil
+}
+
+func (x *SummaryStatistics) GetLifestyleMetrics() []*MetricStatistics {
+	if x != nil {
+		return x.LifestyleMetrics
+	}
+	return nil
+}
+
+func (x *SummaryStatistics) GetWearableMetrics() []*MetricStatistics {
+	if x != nil {
+		return x.WearableMetrics
+	}
+	return nil
+}
+
+func (x *SummaryStatistics) GetRecommendationTypes() map[string]int32 {
+	if x != nil {
+		return x.RecommendationTypes
+	}
+	return nil
+}
+
+type EntityCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MedicalRecords        int32 `protobuf:"varint,1,opt,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+	GeneticData           int32 `protobuf:"varint,2,opt,name=genetic_data,json=geneticData,proto3" json:"genetic_data,omitempty"`
+	LifestyleData         int32 `protobuf:"varint,3,opt,name=lifestyle_data,json=lifestyleData,proto3" json:"lifestyle_data,omitempty"`
+	WearableData          int32 `protobuf:"varint,4,opt,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
+	HealthRecommendations int32 `protobuf:"varint,5,opt,name=health_recommendations,json=healthRecommendations,proto3" json:"health_recommendations,omitempty"`
+}
+
+func (x *EntityCounts) Reset() {
+	*x = EntityCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityCounts) ProtoMessage() {}
+
+func (x *EntityCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityCounts.ProtoReflect.Descriptor instead.
+func (*EntityCounts) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *EntityCounts) GetMedicalRecords() int32 {
+	if x != nil {
+		return x.MedicalRecords
+	}
+	return 0
+}
+
+func (x *EntityCounts) GetGeneticData() int32 {
+	if x != nil {
+		return x.GeneticData
+	}
+	return 0
+}
+
+func (x *EntityCounts) GetLifestyleData() int32 {
+	if x != nil {
+		return x.LifestyleData
+	}
+	return 0
+}
+
+func (x *EntityCounts) GetWearableData() int32 {
+	if x != nil {
+		return x.WearableData
+	}
+	return 0
+}
+
+func (x *EntityCounts) GetHealthRecommendations() int32 {
+	if x != nil {
+		return x.HealthRecommendations
+	}
+	return 0
+}
+
+type MetricStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataType string  `protobuf:"bytes,1,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Count    int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Records with a numeric value
+	Min      float64 `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max      float64 `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Avg      float64 `protobuf:"fixed64,5,opt,name=avg,proto3" json:"avg,omitempty"`
+	Sum      float64 `protobuf:"fixed64,6,opt,name=sum,proto3" json:"sum,omitempty"`
+}
+
+func (x *MetricStatistics) Reset() {
+	*x = MetricStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_medical_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricStatistics) ProtoMessage() {}
+
+func (x *MetricStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_medical_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricStatistics.ProtoReflect.Descriptor instead.
+func (*MetricStatistics) Descriptor() ([]byte, []int) {
+	return file_protos_medical_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MetricStatistics) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *MetricStatistics) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MetricStatistics) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MetricStatistics) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MetricStatistics) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *MetricStatistics) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
 var File_protos_medical_proto protoreflect.FileDescriptor
 
 var file_protos_medical_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x1a, 0x19,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1d, 0x0a, 0x0b, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x0d, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74,
-	0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1d, 0x0a, 0x0b, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x0d, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x74,
+	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0x97, 0x02, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xec, 0x01, 0x0a,
-	0x14, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x97, 0x02, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f,
-	0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x09,
-	0x53, 0x6c, 0x65, 0x65, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xec,
+	0x01, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd2, 0x02,
+	0x0a, 0x0a, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61,
+	0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x26, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f,
+	0x61, 0x6c, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0xc5, 0x02, 0x0a, 0x0f, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6c, 0x65,
+	0x65, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x0d, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0x67, 0x0a, 0x0b, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53,
+	0x6c, 0x65, 0x65, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x0f, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x52, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3d,
+	0x0a, 0x0f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d,
+	0x68, 0x65, 0x61, 0x72, 0x74, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x61, 0x76, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x03, 0x61, 0x76,
+	0x67, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x61, 0x76, 0x67, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x22, 0xc6, 0x02, 0x0a, 0x0d, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0e, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63,
+	0x44, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0c, 0x67, 0x65, 0x6e,
+	0x65, 0x74, 0x69, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x8f,
+	0x01, 0x0a, 0x12, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x22, 0x8b, 0x01, 0x0a, 0x11, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x39, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xab,
+	0x01, 0x0a, 0x19, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x51, 0x0a, 0x15,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x83, 0x01, 0x0a,
+	0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x33, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x67, 0x6f, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0xdc, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x22, 0xa2, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69,
+	0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x44, 0x61, 0x74,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x44, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0xa4, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x28, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0xe2, 0x02,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
 	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x6c, 0x65, 0x65,
-	0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6c, 0x65,
-	0x65, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x72, 0x74, 0x52, 0x61, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x68, 0x65, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x07, 0x0a, 0x05, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x44, 0x61, 0x74,
-	0x65, 0x22, 0x75, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x88, 0x01, 0x0a, 0x20, 0x4c,
-	0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x36, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x74, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x22, 0xdf, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x22, 0xd5, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x5c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x22, 0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x84, 0x01, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x6d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x79, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74,
 	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
 	0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65,
 	0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x74,
-	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x22, 0x55, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
-	0x0d, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65,
-	0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x78, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
-	0x16, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x42, 0x0a, 0x13, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81,
+	0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e,
+	0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x0d, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57,
+	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42,
+	0x0a, 0x13, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0x69, 0x0a, 0x14, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd7, 0x02,
+	0x0a, 0x0f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x36, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x67, 0x65,
+	0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x0e, 0x6c, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x53, 0x0a, 0x16, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
 	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x14, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x22, 0xd7, 0x02, 0x0a, 0x0f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
-	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xcc, 0x05, 0x0a, 0x11, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x63,
+	0x0a, 0x14, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x12, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x74,
+	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x5f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x10, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x77, 0x65, 0x61,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0f, 0x77,
+	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x65,
+	0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15,
+	0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x46, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x0c, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x74,
+	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a,
+	0x0d, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x35, 0x0a, 0x16, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x76, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x32, 0xad, 0x01, 0x0a, 0x17, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x65, 0x6b,
+	0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe8, 0x02, 0x0a, 0x14, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x3b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x3b, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x0d, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x21, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcf, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69,
+	0x63, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74,
+	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x68, 0x65,
 	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a,
-	0x0e, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0d, 0x77,
-	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x16, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xad, 0x01, 0x0a, 0x17,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65,
-	0x65, 0x6b, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe8, 0x02, 0x0a, 0x14,
-	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x15, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42,
-	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x12, 0x3b, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
-	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a,
-	0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39,
-	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42,
-	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
-	0x21, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcf, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x74,
-	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x6e, 0x65,
-	0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e,
-	0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x37, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65,
-	0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x37, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69,
+	0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x13,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69,
+	0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe5, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x13,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
 	0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65,
-	0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe5, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x3b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a,
-	0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b,
-	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x68, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x68, 0x65,
 	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xda, 0x02, 0x0a, 0x13, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x39, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
-	0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb5, 0x03,
-	0x0a, 0x1b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a,
-	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xda,
+	0x02, 0x0a, 0x13, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x3c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x39, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x57,
+	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb5, 0x03, 0x0a, 0x1b,
 	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x40, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x70, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x1a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65,
 	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x40, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x70, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xc7, 0x02, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x10, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x12, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61,
+	0x6c, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x38, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61,
+	0x6c, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x10, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x12,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f,
+	0x61, 0x6c, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x36, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x13, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x11, 0x5a,
+	0x0f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1880,7 +4143,7 @@ func file_protos_medical_proto_rawDescGZIP() []byte {
 	return file_protos_medical_proto_rawDescData
 }
 
-var file_protos_medical_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_protos_medical_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_protos_medical_proto_goTypes = []any{
 	(*ByIdRequest)(nil),                       // 0: health.ByIdRequest
 	(*MedicalRecord)(nil),                     // 1: health.MedicalRecord
@@ -1888,97 +4151,160 @@ var file_protos_medical_proto_goTypes = []any{
 	(*LifestyleData)(nil),                     // 3: health.LifestyleData
 	(*WearableData)(nil),                      // 4: health.WearableData
 	(*HealthRecommendation)(nil),              // 5: health.HealthRecommendation
-	(*SleepData)(nil),                         // 6: health.SleepData
-	(*HeartRateData)(nil),                     // 7: health.HeartRateData
-	(*Empty)(nil),                             // 8: health.Empty
-	(*ListMedicalRecordsRequest)(nil),         // 9: health.ListMedicalRecordsRequest
-	(*ListGeneticDataRequest)(nil),            // 10: health.ListGeneticDataRequest
-	(*ListLifestyleDataRequest)(nil),          // 11: health.ListLifestyleDataRequest
-	(*ListWearableDataRequest)(nil),           // 12: health.ListWearableDataRequest
-	(*ListHealthRecommendationsRequest)(nil),  // 13: health.ListHealthRecommendationsRequest
-	(*ListMedicalRecordsResponse)(nil),        // 14: health.ListMedicalRecordsResponse
-	(*ListGeneticDataResponse)(nil),           // 15: health.ListGeneticDataResponse
-	(*ListLifestyleDataResponse)(nil),         // 16: health.ListLifestyleDataResponse
-	(*ListWearableDataResponse)(nil),          // 17: health.ListWearableDataResponse
-	(*ListHealthRecommendationsResponse)(nil), // 18: health.ListHealthRecommendationsResponse
-	(*DailySummaryRequest)(nil),               // 19: health.DailySummaryRequest
-	(*WeeklySummaryRequest)(nil),              // 20: health.WeeklySummaryRequest
-	(*SummaryResponse)(nil),                   // 21: health.SummaryResponse
-	(*anypb.Any)(nil),                         // 22: google.protobuf.Any
+	(*HealthGoal)(nil),                        // 6: health.HealthGoal
+	(*HealthGoalPeriod)(nil),                  // 7: health.HealthGoalPeriod
+	(*HealthGoalProgress)(nil),                // 8: health.HealthGoalProgress
+	(*HealthGoalEvent)(nil),                   // 9: health.HealthGoalEvent
+	(*SleepData)(nil),                         // 10: health.SleepData
+	(*HeartRateData)(nil),                     // 11: health.HeartRateData
+	(*SleepSeries)(nil),                       // 12: health.SleepSeries
+	(*HeartRateSeries)(nil),                   // 13: health.HeartRateSeries
+	(*WearableDataBucket)(nil),                // 14: health.WearableDataBucket
+	(*WearableDataAggregate)(nil),             // 15: health.WearableDataAggregate
+	(*WearableAlert)(nil),                     // 16: health.WearableAlert
+	(*Empty)(nil),                             // 17: health.Empty
+	(*MedicalRecordPatch)(nil),                // 18: health.MedicalRecordPatch
+	(*GeneticDataPatch)(nil),                  // 19: health.GeneticDataPatch
+	(*LifestyleDataPatch)(nil),                // 20: health.LifestyleDataPatch
+	(*WearableDataPatch)(nil),                 // 21: health.WearableDataPatch
+	(*HealthRecommendationPatch)(nil),         // 22: health.HealthRecommendationPatch
+	(*HealthGoalPatch)(nil),                   // 23: health.HealthGoalPatch
+	(*ListMedicalRecordsRequest)(nil),         // 24: health.ListMedicalRecordsRequest
+	(*ListGeneticDataRequest)(nil),            // 25: health.ListGeneticDataRequest
+	(*ListLifestyleDataRequest)(nil),          // 26: health.ListLifestyleDataRequest
+	(*ListWearableDataRequest)(nil),           // 27: health.ListWearableDataRequest
+	(*ListHealthRecommendationsRequest)(nil),  // 28: health.ListHealthRecommendationsRequest
+	(*ListHealthGoalsRequest)(nil),            // 29: health.ListHealthGoalsRequest
+	(*ListMedicalRecordsResponse)(nil),        // 30: health.ListMedicalRecordsResponse
+	(*ListGeneticDataResponse)(nil),           // 31: health.ListGeneticDataResponse
+	(*ListLifestyleDataResponse)(nil),         // 32: health.ListLifestyleDataResponse
+	(*ListWearableDataResponse)(nil),          // 33: health.ListWearableDataResponse
+	(*ListHealthRecommendationsResponse)(nil), // 34: health.ListHealthRecommendationsResponse
+	(*ListHealthGoalsResponse)(nil),           // 35: health.ListHealthGoalsResponse
+	(*DailySummaryRequest)(nil),               // 36: health.DailySummaryRequest
+	(*WeeklySummaryRequest)(nil),              // 37: health.WeeklySummaryRequest
+	(*SummaryResponse)(nil),                   // 38: health.SummaryResponse
+	(*SummaryReport)(nil),                     // 39: health.SummaryReport
+	(*SummaryStatistics)(nil),                 // 40: health.SummaryStatistics
+	(*EntityCounts)(nil),                      // 41: health.EntityCounts
+	(*MetricStatistics)(nil),                  // 42: health.MetricStatistics
+	nil,                                       // 43: health.WearableDataBucket.PercentilesEntry
+	nil,                                       // 44: health.SummaryStatistics.MedicalRecordTypesEntry
+	nil,                                       // 45: health.SummaryStatistics.GeneticDataTypesEntry
+	nil,                                       // 46: health.SummaryStatistics.RecommendationTypesEntry
+	(*anypb.Any)(nil),                         // 47: google.protobuf.Any
+	(*fieldmaskpb.FieldMask)(nil),             // 48: google.protobuf.FieldMask
 }
 var file_protos_medical_proto_depIdxs = []int32{
-	22, // 0: health.GeneticData.data_value:type_name -> google.protobuf.Any
-	22, // 1: health.LifestyleData.data_value:type_name -> google.protobuf.Any
-	22, // 2: health.WearableData.data_value:type_name -> google.protobuf.Any
-	1,  // 3: health.ListMedicalRecordsResponse.medical_records:type_name -> health.MedicalRecord
-	2,  // 4: health.ListGeneticDataResponse.genetic_data:type_name -> health.GeneticData
-	3,  // 5: health.ListLifestyleDataResponse.lifestyle_data:type_name -> health.LifestyleData
-	4,  // 6: health.ListWearableDataResponse.wearable_data:type_name -> health.WearableData
-	5,  // 7: health.ListHealthRecommendationsResponse.health_recommendations:type_name -> health.HealthRecommendation
-	1,  // 8: health.SummaryResponse.medical_records:type_name -> health.MedicalRecord
-	2,  // 9: health.SummaryResponse.genetic_data:type_name -> health.GeneticData
-	3,  // 10: health.SummaryResponse.lifestyle_data:type_name -> health.LifestyleData
-	4,  // 11: health.SummaryResponse.wearable_data:type_name -> health.WearableData
-	5,  // 12: health.SummaryResponse.health_recommendations:type_name -> health.HealthRecommendation
-	19, // 13: health.HealthMonitoringService.GetDailySummary:input_type -> health.DailySummaryRequest
-	20, // 14: health.HealthMonitoringService.GetWeeklySummary:input_type -> health.WeeklySummaryRequest
-	1,  // 15: health.MedicalRecordService.CreateMedicalRecord:input_type -> health.MedicalRecord
-	0,  // 16: health.MedicalRecordService.GetMedicalRecord:input_type -> health.ByIdRequest
-	1,  // 17: health.MedicalRecordService.UpdateMedicalRecord:input_type -> health.MedicalRecord
-	0,  // 18: health.MedicalRecordService.DeleteMedicalRecord:input_type -> health.ByIdRequest
-	9,  // 19: health.MedicalRecordService.ListMedicalRecords:input_type -> health.ListMedicalRecordsRequest
-	2,  // 20: health.GeneticDataService.CreateGeneticData:input_type -> health.GeneticData
-	0,  // 21: health.GeneticDataService.GetGeneticData:input_type -> health.ByIdRequest
-	2,  // 22: health.GeneticDataService.UpdateGeneticData:input_type -> health.GeneticData
-	0,  // 23: health.GeneticDataService.DeleteGeneticData:input_type -> health.ByIdRequest
-	10, // 24: health.GeneticDataService.ListGeneticData:input_type -> health.ListGeneticDataRequest
-	3,  // 25: health.LifestyleDataService.CreateLifestyleData:input_type -> health.LifestyleData
-	0,  // 26: health.LifestyleDataService.GetLifestyleData:input_type -> health.ByIdRequest
-	3,  // 27: health.LifestyleDataService.UpdateLifestyleData:input_type -> health.LifestyleData
-	0,  // 28: health.LifestyleDataService.DeleteLifestyleData:input_type -> health.ByIdRequest
-	11, // 29: health.LifestyleDataService.ListLifestyleData:input_type -> health.ListLifestyleDataRequest
-	4,  // 30: health.WearableDataService.CreateWearableData:input_type -> health.WearableData
-	0,  // 31: health.WearableDataService.GetWearableData:input_type -> health.ByIdRequest
-	4,  // 32: health.WearableDataService.UpdateWearableData:input_type -> health.WearableData
-	0,  // 33: health.WearableDataService.DeleteWearableData:input_type -> health.ByIdRequest
-	12, // 34: health.WearableDataService.ListWearableData:input_type -> health.ListWearableDataRequest
-	5,  // 35: health.HealthRecommendationService.CreateHealthRecommendation:input_type -> health.HealthRecommendation
-	0,  // 36: health.HealthRecommendationService.GetHealthRecommendation:input_type -> health.ByIdRequest
-	5,  // 37: health.HealthRecommendationService.UpdateHealthRecommendation:input_type -> health.HealthRecommendation
-	0,  // 38: health.HealthRecommendationService.DeleteHealthRecommendation:input_type -> health.ByIdRequest
-	13, // 39: health.HealthRecommendationService.ListHealthRecommendations:input_type -> health.ListHealthRecommendationsRequest
-	21, // 40: health.HealthMonitoringService.GetDailySummary:output_type -> health.SummaryResponse
-	21, // 41: health.HealthMonitoringService.GetWeeklySummary:output_type -> health.SummaryResponse
-	8,  // 42: health.MedicalRecordService.CreateMedicalRecord:output_type -> health.Empty
-	1,  // 43: health.MedicalRecordService.GetMedicalRecord:output_type -> health.MedicalRecord
-	8,  // 44: health.MedicalRecordService.UpdateMedicalRecord:output_type -> health.Empty
-	8,  // 45: health.MedicalRecordService.DeleteMedicalRecord:output_type -> health.Empty
-	14, // 46: health.MedicalRecordService.ListMedicalRecords:output_type -> health.ListMedicalRecordsResponse
-	8,  // 47: health.GeneticDataService.CreateGeneticData:output_type -> health.Empty
-	2,  // 48: health.GeneticDataService.GetGeneticData:output_type -> health.GeneticData
-	8,  // 49: health.GeneticDataService.UpdateGeneticData:output_type -> health.Empty
-	8,  // 50: health.GeneticDataService.DeleteGeneticData:output_type -> health.Empty
-	15, // 51: health.GeneticDataService.ListGeneticData:output_type -> health.ListGeneticDataResponse
-	8,  // 52: health.LifestyleDataService.CreateLifestyleData:output_type -> health.Empty
-	3,  // 53: health.LifestyleDataService.GetLifestyleData:output_type -> health.LifestyleData
-	8,  // 54: health.LifestyleDataService.UpdateLifestyleData:output_type -> health.Empty
-	8,  // 55: health.LifestyleDataService.DeleteLifestyleData:output_type -> health.Empty
-	16, // 56: health.LifestyleDataService.ListLifestyleData:output_type -> health.ListLifestyleDataResponse
-	8,  // 57: health.WearableDataService.CreateWearableData:output_type -> health.Empty
-	4,  // 58: health.WearableDataService.GetWearableData:output_type -> health.WearableData
-	8,  // 59: health.WearableDataService.UpdateWearableData:output_type -> health.Empty
-	8,  // 60: health.WearableDataService.DeleteWearableData:output_type -> health.Empty
-	17, // 61: health.WearableDataService.ListWearableData:output_type -> health.ListWearableDataResponse
-	8,  // 62: health.HealthRecommendationService.CreateHealthRecommendation:output_type -> health.Empty
-	5,  // 63: health.HealthRecommendationService.GetHealthRecommendation:output_type -> health.HealthRecommendation
-	8,  // 64: health.HealthRecommendationService.UpdateHealthRecommendation:output_type -> health.Empty
-	8,  // 65: health.HealthRecommendationService.DeleteHealthRecommendation:output_type -> health.Empty
-	18, // 66: health.HealthRecommendationService.ListHealthRecommendations:output_type -> health.ListHealthRecommendationsResponse
-	40, // [40:67] is the sub-list for method output_type
-	13, // [13:40] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	47, // 0: health.GeneticData.data_value:type_name -> google.protobuf.Any
+	47, // 1: health.LifestyleData.data_value:type_name -> google.protobuf.Any
+	47, // 2: health.WearableData.data_value:type_name -> google.protobuf.Any
+	6,  // 3: health.HealthGoalProgress.goal:type_name -> health.HealthGoal
+	7,  // 4: health.HealthGoalProgress.periods:type_name -> health.HealthGoalPeriod
+	10, // 5: health.SleepSeries.sleep_data:type_name -> health.SleepData
+	11, // 6: health.HeartRateSeries.heart_rate_data:type_name -> health.HeartRateData
+	43, // 7: health.WearableDataBucket.percentiles:type_name -> health.WearableDataBucket.PercentilesEntry
+	14, // 8: health.WearableDataAggregate.buckets:type_name -> health.WearableDataBucket
+	1,  // 9: health.MedicalRecordPatch.medical_record:type_name -> health.MedicalRecord
+	48, // 10: health.MedicalRecordPatch.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 11: health.GeneticDataPatch.genetic_data:type_name -> health.GeneticData
+	48, // 12: health.GeneticDataPatch.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 13: health.LifestyleDataPatch.lifestyle_data:type_name -> health.LifestyleData
+	48, // 14: health.LifestyleDataPatch.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 15: health.WearableDataPatch.wearable_data:type_name -> health.WearableData
+	48, // 16: health.WearableDataPatch.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 17: health.HealthRecommendationPatch.health_recommendation:type_name -> health.HealthRecommendation
+	48, // 18: health.HealthRecommendationPatch.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 19: health.HealthGoalPatch.health_goal:type_name -> health.HealthGoal
+	48, // 20: health.HealthGoalPatch.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 21: health.ListMedicalRecordsResponse.medical_records:type_name -> health.MedicalRecord
+	2,  // 22: health.ListGeneticDataResponse.genetic_data:type_name -> health.GeneticData
+	3,  // 23: health.ListLifestyleDataResponse.lifestyle_data:type_name -> health.LifestyleData
+	4,  // 24: health.ListWearableDataResponse.wearable_data:type_name -> health.WearableData
+	5,  // 25: health.ListHealthRecommendationsResponse.health_recommendations:type_name -> health.HealthRecommendation
+	6,  // 26: health.ListHealthGoalsResponse.health_goals:type_name -> health.HealthGoal
+	1,  // 27: health.SummaryResponse.medical_records:type_name -> health.MedicalRecord
+	2,  // 28: health.SummaryResponse.genetic_data:type_name -> health.GeneticData
+	3,  // 29: health.SummaryResponse.lifestyle_data:type_name -> health.LifestyleData
+	4,  // 30: health.SummaryResponse.wearable_data:type_name -> health.WearableData
+	5,  // 31: health.SummaryResponse.health_recommendations:type_name -> health.HealthRecommendation
+	40, // 32: health.SummaryReport.statistics:type_name -> health.SummaryStatistics
+	38, // 33: health.SummaryReport.records:type_name -> health.SummaryResponse
+	41, // 34: health.SummaryStatistics.counts:type_name -> health.EntityCounts
+	44, // 35: health.SummaryStatistics.medical_record_types:type_name -> health.SummaryStatistics.MedicalRecordTypesEntry
+	45, // 36: health.SummaryStatistics.genetic_data_types:type_name -> health.SummaryStatistics.GeneticDataTypesEntry
+	42, // 37: health.SummaryStatistics.lifestyle_metrics:type_name -> health.MetricStatistics
+	42, // 38: health.SummaryStatistics.wearable_metrics:type_name -> health.MetricStatistics
+	46, // 39: health.SummaryStatistics.recommendation_types:type_name -> health.SummaryStatistics.RecommendationTypesEntry
+	36, // 40: health.HealthMonitoringService.GetDailySummary:input_type -> health.DailySummaryRequest
+	37, // 41: health.HealthMonitoringService.GetWeeklySummary:input_type -> health.WeeklySummaryRequest
+	1,  // 42: health.MedicalRecordService.CreateMedicalRecord:input_type -> health.MedicalRecord
+	0,  // 43: health.MedicalRecordService.GetMedicalRecord:input_type -> health.ByIdRequest
+	1,  // 44: health.MedicalRecordService.UpdateMedicalRecord:input_type -> health.MedicalRecord
+	0,  // 45: health.MedicalRecordService.DeleteMedicalRecord:input_type -> health.ByIdRequest
+	24, // 46: health.MedicalRecordService.ListMedicalRecords:input_type -> health.ListMedicalRecordsRequest
+	2,  // 47: health.GeneticDataService.CreateGeneticData:input_type -> health.GeneticData
+	0,  // 48: health.GeneticDataService.GetGeneticData:input_type -> health.ByIdRequest
+	2,  // 49: health.GeneticDataService.UpdateGeneticData:input_type -> health.GeneticData
+	0,  // 50: health.GeneticDataService.DeleteGeneticData:input_type -> health.ByIdRequest
+	25, // 51: health.GeneticDataService.ListGeneticData:input_type -> health.ListGeneticDataRequest
+	3,  // 52: health.LifestyleDataService.CreateLifestyleData:input_type -> health.LifestyleData
+	0,  // 53: health.LifestyleDataService.GetLifestyleData:input_type -> health.ByIdRequest
+	3,  // 54: health.LifestyleDataService.UpdateLifestyleData:input_type -> health.LifestyleData
+	0,  // 55: health.LifestyleDataService.DeleteLifestyleData:input_type -> health.ByIdRequest
+	26, // 56: health.LifestyleDataService.ListLifestyleData:input_type -> health.ListLifestyleDataRequest
+	4,  // 57: health.WearableDataService.CreateWearableData:input_type -> health.WearableData
+	0,  // 58: health.WearableDataService.GetWearableData:input_type -> health.ByIdRequest
+	4,  // 59: health.WearableDataService.UpdateWearableData:input_type -> health.WearableData
+	0,  // 60: health.WearableDataService.DeleteWearableData:input_type -> health.ByIdRequest
+	27, // 61: health.WearableDataService.ListWearableData:input_type -> health.ListWearableDataRequest
+	5,  // 62: health.HealthRecommendationService.CreateHealthRecommendation:input_type -> health.HealthRecommendation
+	0,  // 63: health.HealthRecommendationService.GetHealthRecommendation:input_type -> health.ByIdRequest
+	5,  // 64: health.HealthRecommendationService.UpdateHealthRecommendation:input_type -> health.HealthRecommendation
+	0,  // 65: health.HealthRecommendationService.DeleteHealthRecommendation:input_type -> health.ByIdRequest
+	28, // 66: health.HealthRecommendationService.ListHealthRecommendations:input_type -> health.ListHealthRecommendationsRequest
+	6,  // 67: health.HealthGoalService.CreateHealthGoal:input_type -> health.HealthGoal
+	0,  // 68: health.HealthGoalService.GetHealthGoal:input_type -> health.ByIdRequest
+	6,  // 69: health.HealthGoalService.UpdateHealthGoal:input_type -> health.HealthGoal
+	0,  // 70: health.HealthGoalService.DeleteHealthGoal:input_type -> health.ByIdRequest
+	29, // 71: health.HealthGoalService.ListHealthGoals:input_type -> health.ListHealthGoalsRequest
+	38, // 72: health.HealthMonitoringService.GetDailySummary:output_type -> health.SummaryResponse
+	38, // 73: health.HealthMonitoringService.GetWeeklySummary:output_type -> health.SummaryResponse
+	17, // 74: health.MedicalRecordService.CreateMedicalRecord:output_type -> health.Empty
+	1,  // 75: health.MedicalRecordService.GetMedicalRecord:output_type -> health.MedicalRecord
+	17, // 76: health.MedicalRecordService.UpdateMedicalRecord:output_type -> health.Empty
+	17, // 77: health.MedicalRecordService.DeleteMedicalRecord:output_type -> health.Empty
+	30, // 78: health.MedicalRecordService.ListMedicalRecords:output_type -> health.ListMedicalRecordsResponse
+	17, // 79: health.GeneticDataService.CreateGeneticData:output_type -> health.Empty
+	2,  // 80: health.GeneticDataService.GetGeneticData:output_type -> health.GeneticData
+	17, // 81: health.GeneticDataService.UpdateGeneticData:output_type -> health.Empty
+	17, // 82: health.GeneticDataService.DeleteGeneticData:output_type -> health.Empty
+	31, // 83: health.GeneticDataService.ListGeneticData:output_type -> health.ListGeneticDataResponse
+	17, // 84: health.LifestyleDataService.CreateLifestyleData:output_type -> health.Empty
+	3,  // 85: health.LifestyleDataService.GetLifestyleData:output_type -> health.LifestyleData
+	17, // 86: health.LifestyleDataService.UpdateLifestyleData:output_type -> health.Empty
+	17, // 87: health.LifestyleDataService.DeleteLifestyleData:output_type -> health.Empty
+	32, // 88: health.LifestyleDataService.ListLifestyleData:output_type -> health.ListLifestyleDataResponse
+	17, // 89: health.WearableDataService.CreateWearableData:output_type -> health.Empty
+	4,  // 90: health.WearableDataService.GetWearableData:output_type -> health.WearableData
+	17, // 91: health.WearableDataService.UpdateWearableData:output_type -> health.Empty
+	17, // 92: health.WearableDataService.DeleteWearableData:output_type -> health.Empty
+	33, // 93: health.WearableDataService.ListWearableData:output_type -> health.ListWearableDataResponse
+	17, // 94: health.HealthRecommendationService.CreateHealthRecommendation:output_type -> health.Empty
+	5,  // 95: health.HealthRecommendationService.GetHealthRecommendation:output_type -> health.HealthRecommendation
+	17, // 96: health.HealthRecommendationService.UpdateHealthRecommendation:output_type -> health.Empty
+	17, // 97: health.HealthRecommendationService.DeleteHealthRecommendation:output_type -> health.Empty
+	34, // 98: health.HealthRecommendationService.ListHealthRecommendations:output_type -> health.ListHealthRecommendationsResponse
+	17, // 99: health.HealthGoalService.CreateHealthGoal:output_type -> health.Empty
+	6,  // 100: health.HealthGoalService.GetHealthGoal:output_type -> health.HealthGoal
+	17, // 101: health.HealthGoalService.UpdateHealthGoal:output_type -> health.Empty
+	17, // 102: health.HealthGoalService.DeleteHealthGoal:output_type -> health.Empty
+	35, // 103: health.HealthGoalService.ListHealthGoals:output_type -> health.ListHealthGoalsResponse
+	72, // [72:104] is the sub-list for method output_type
+	40, // [40:72] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_protos_medical_proto_init() }
@@ -2060,7 +4386,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*SleepData); i {
+			switch v := v.(*HealthGoal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2072,7 +4398,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*HeartRateData); i {
+			switch v := v.(*HealthGoalPeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2084,7 +4410,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Empty); i {
+			switch v := v.(*HealthGoalProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2096,7 +4422,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ListMedicalRecordsRequest); i {
+			switch v := v.(*HealthGoalEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2108,7 +4434,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ListGeneticDataRequest); i {
+			switch v := v.(*SleepData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2120,7 +4446,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ListLifestyleDataRequest); i {
+			switch v := v.(*HeartRateData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2132,7 +4458,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*ListWearableDataRequest); i {
+			switch v := v.(*SleepSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2144,7 +4470,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*ListHealthRecommendationsRequest); i {
+			switch v := v.(*HeartRateSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2156,7 +4482,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*ListMedicalRecordsResponse); i {
+			switch v := v.(*WearableDataBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2168,7 +4494,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*ListGeneticDataResponse); i {
+			switch v := v.(*WearableDataAggregate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2180,7 +4506,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ListLifestyleDataResponse); i {
+			switch v := v.(*WearableAlert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2192,7 +4518,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ListWearableDataResponse); i {
+			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2204,7 +4530,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ListHealthRecommendationsResponse); i {
+			switch v := v.(*MedicalRecordPatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2216,7 +4542,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*DailySummaryRequest); i {
+			switch v := v.(*GeneticDataPatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2228,7 +4554,7 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*WeeklySummaryRequest); i {
+			switch v := v.(*LifestyleDataPatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2240,6 +4566,210 @@ func file_protos_medical_proto_init() {
 			}
 		}
 		file_protos_medical_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*WearableDataPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthRecommendationPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthGoalPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMedicalRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGeneticDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWearableDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ListHealthRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ListHealthGoalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMedicalRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGeneticDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWearableDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ListHealthRecommendationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ListHealthGoalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DailySummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*WeeklySummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*SummaryResponse); i {
 			case 0:
 				return &v.state
@@ -2251,16 +4781,65 @@ func file_protos_medical_proto_init() {
 				return nil
 			}
 		}
+		file_protos_medical_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SummaryReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*SummaryStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_medical_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_protos_medical_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_medical_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   47,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   7,
 		},
 		GoTypes:           file_protos_medical_proto_goTypes,
 		DependencyIndexes: file_protos_medical_proto_depIdxs,
@@ -1437,3 +1437,257 @@ var HealthRecommendationService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/medical.proto",
 }
+
+const (
+	HealthGoalService_CreateHealthGoal_FullMethodName = "/health.HealthGoalService/CreateHealthGoal"
+	HealthGoalService_GetHealthGoal_FullMethodName    = "/health.HealthGoalService/GetHealthGoal"
+	HealthGoalService_UpdateHealthGoal_FullMethodName = "/health.HealthGoalService/UpdateHealthGoal"
+	HealthGoalService_DeleteHealthGoal_FullMethodName = "/health.HealthGoalService/DeleteHealthGoal"
+	HealthGoalService_ListHealthGoals_FullMethodName  = "/health.HealthGoalService/ListHealthGoals"
+)
+
+// HealthGoalServiceClient is the client API for HealthGoalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HealthGoalServiceClient interface {
+	CreateHealthGoal(ctx context.Context, in *HealthGoal, opts ...grpc.CallOption) (*Empty, error)
+	GetHealthGoal(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*HealthGoal, error)
+	UpdateHealthGoal(ctx context.Context, in *HealthGoal, opts ...grpc.CallOption) (*Empty, error)
+	DeleteHealthGoal(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListHealthGoals(ctx context.Context, in *ListHealthGoalsRequest, opts ...grpc.CallOption) (*ListHealthGoalsResponse, error)
+}
+
+type healthGoalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHealthGoalServiceClient(cc grpc.ClientConnInterface) HealthGoalServiceClient {
+	return &healthGoalServiceClient{cc}
+}
+
+func (c *healthGoalServiceClient) CreateHealthGoal(ctx context.Context, in *HealthGoal, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, HealthGoalService_CreateHealthGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthGoalServiceClient) GetHealthGoal(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*HealthGoal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthGoal)
+	err := c.cc.Invoke(ctx, HealthGoalService_GetHealthGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthGoalServiceClient) UpdateHealthGoal(ctx context.Context, in *HealthGoal, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, HealthGoalService_UpdateHealthGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthGoalServiceClient) DeleteHealthGoal(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, HealthGoalService_DeleteHealthGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthGoalServiceClient) ListHealthGoals(ctx context.Context, in *ListHealthGoalsRequest, opts ...grpc.CallOption) (*ListHealthGoalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHealthGoalsResponse)
+	err := c.cc.Invoke(ctx, HealthGoalService_ListHealthGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HealthGoalServiceServer is the server API for HealthGoalService service.
+// All implementations must embed UnimplementedHealthGoalServiceServer
+// for forward compatibility.
+type HealthGoalServiceServer interface {
+	CreateHealthGoal(context.Context, *HealthGoal) (*Empty, error)
+	GetHealthGoal(context.Context, *ByIdRequest) (*HealthGoal, error)
+	UpdateHealthGoal(context.Context, *HealthGoal) (*Empty, error)
+	DeleteHealthGoal(context.Context, *ByIdRequest) (*Empty, error)
+	ListHealthGoals(context.Context, *ListHealthGoalsRequest) (*ListHealthGoalsResponse, error)
+	mustEmbedUnimplementedHealthGoalServiceServer()
+}
+
+// UnimplementedHealthGoalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHealthGoalServiceServer struct{}
+
+func (UnimplementedHealthGoalServiceServer) CreateHealthGoal(context.Context, *HealthGoal) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHealthGoal not implemented")
+}
+func (UnimplementedHealthGoalServiceServer) GetHealthGoal(context.Context, *ByIdRequest) (*HealthGoal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthGoal not implemented")
+}
+func (UnimplementedHealthGoalServiceServer) UpdateHealthGoal(context.Context, *HealthGoal) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHealthGoal not implemented")
+}
+func (UnimplementedHealthGoalServiceServer) DeleteHealthGoal(context.Context, *ByIdRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHealthGoal not implemented")
+}
+func (UnimplementedHealthGoalServiceServer) ListHealthGoals(context.Context, *ListHealthGoalsRequest) (*ListHealthGoalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHealthGoals not implemented")
+}
+func (UnimplementedHealthGoalServiceServer) mustEmbedUnimplementedHealthGoalServiceServer() {}
+func (UnimplementedHealthGoalServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeHealthGoalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HealthGoalServiceServer will
+// result in compilation errors.
+type UnsafeHealthGoalServiceServer interface {
+	mustEmbedUnimplementedHealthGoalServiceServer()
+}
+
+func RegisterHealthGoalServiceServer(s grpc.ServiceRegistrar, srv HealthGoalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHealthGoalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HealthGoalService_ServiceDesc, srv)
+}
+
+func _HealthGoalService_CreateHealthGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthGoal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthGoalServiceServer).CreateHealthGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthGoalService_CreateHealthGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthGoalServiceServer).CreateHealthGoal(ctx, req.(*HealthGoal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthGoalService_GetHealthGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthGoalServiceServer).GetHealthGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthGoalService_GetHealthGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthGoalServiceServer).GetHealthGoal(ctx, req.(*ByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthGoalService_UpdateHealthGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthGoal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthGoalServiceServer).UpdateHealthGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthGoalService_UpdateHealthGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthGoalServiceServer).UpdateHealthGoal(ctx, req.(*HealthGoal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthGoalService_DeleteHealthGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthGoalServiceServer).DeleteHealthGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthGoalService_DeleteHealthGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthGoalServiceServer).DeleteHealthGoal(ctx, req.(*ByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthGoalService_ListHealthGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHealthGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthGoalServiceServer).ListHealthGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthGoalService_ListHealthGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthGoalServiceServer).ListHealthGoals(ctx, req.(*ListHealthGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HealthGoalService_ServiceDesc is the grpc.ServiceDesc for HealthGoalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HealthGoalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "health.HealthGoalService",
+	HandlerType: (*HealthGoalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateHealthGoal",
+			Handler:    _HealthGoalService_CreateHealthGoal_Handler,
+		},
+		{
+			MethodName: "GetHealthGoal",
+			Handler:    _HealthGoalService_GetHealthGoal_Handler,
+		},
+		{
+			MethodName: "UpdateHealthGoal",
+			Handler:    _HealthGoalService_UpdateHealthGoal_Handler,
+		},
+		{
+			MethodName: "DeleteHealthGoal",
+			Handler:    _HealthGoalService_DeleteHealthGoal_Handler,
+		},
+		{
+			MethodName: "ListHealthGoals",
+			Handler:    _HealthGoalService_ListHealthGoals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/medical.proto",
+}
@@ -0,0 +1,60 @@
+package goals
+
+import (
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
+)
+
+// dateLayout is the format of the date fields of the health goals.
+const dateLayout = "2006-01-02"
+
+// Periods of a goal.
+const (
+	PeriodDaily  = "daily"
+	PeriodWeekly = "weekly"
+)
+
+// Periods returns the periods of goal up to the one holding today, oldest
+// first. Weekly periods start on the weekday of the goal's start date and the
+// last period is cut short by its end date. Only the periods overlapping the
+// maxDays days up to today are returned, and none before the goal starts.
+func Periods(goal *health.HealthGoal, today time.Time, maxDays int) ([]summary.Period, error) {
+	start, err := time.Parse(dateLayout, goal.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	last := today
+	var goalEnd time.Time
+	if goal.EndDate != "" {
+		if goalEnd, err = time.Parse(dateLayout, goal.EndDate); err != nil {
+			return nil, err
+		}
+		if goalEnd.Before(last) {
+			last = goalEnd
+		}
+	}
+	if last.Before(start) {
+		return nil, nil
+	}
+
+	days := 1
+	if goal.Period == PeriodWeekly {
+		days = summary.WeekDays
+	}
+
+	// Skip the whole periods before the earliest day reported
+	first := start
+	if earliest := last.AddDate(0, 0, 1-maxDays); earliest.After(first) {
+		skipped := (summary.Period{Start: first, End: earliest}.Days() - 1) / days
+		first = first.AddDate(0, 0, skipped*days)
+	}
+
+	periods := (summary.Period{Start: first, End: last}.Days()-1)/days + 1
+	end := first.AddDate(0, 0, periods*days-1)
+	if !goalEnd.IsZero() && end.After(goalEnd) {
+		end = goalEnd
+	}
+	return summary.Period{Start: first, End: end}.Split(days), nil
+}
@@ -0,0 +1,100 @@
+package goals
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
+)
+
+// date parses a YYYY-MM-DD date.
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	d, err := time.Parse(dateLayout, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// format joins periods as space separated start..end dates.
+func format(periods []summary.Period) string {
+	parts := make([]string, len(periods))
+	for i, p := range periods {
+		parts[i] = p.Start.Format(dateLayout) + ".." + p.End.Format(dateLayout)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestPeriods(t *testing.T) {
+	tests := []struct {
+		name               string
+		period             string
+		startDate, endDate string
+		maxDays            int
+		want               string
+		wantErr            bool
+	}{
+		{
+			name:   "daily",
+			period: PeriodDaily, startDate: "2026-10-17", maxDays: 30,
+			want: "2026-10-17..2026-10-17 2026-10-18..2026-10-18 2026-10-19..2026-10-19",
+		},
+		{
+			name:   "weekly starts on the start weekday",
+			period: PeriodWeekly, startDate: "2026-10-01", maxDays: 30,
+			want: "2026-10-01..2026-10-07 2026-10-08..2026-10-14 2026-10-15..2026-10-21",
+		},
+		{
+			name:   "end date cuts the last week short",
+			period: PeriodWeekly, startDate: "2026-10-01", endDate: "2026-10-17", maxDays: 30,
+			want: "2026-10-01..2026-10-07 2026-10-08..2026-10-14 2026-10-15..2026-10-17",
+		},
+		{
+			name:   "ended goal stops at its end date",
+			period: PeriodDaily, startDate: "2026-10-10", endDate: "2026-10-11", maxDays: 30,
+			want: "2026-10-10..2026-10-10 2026-10-11..2026-10-11",
+		},
+		{
+			name:   "daily limited to max days",
+			period: PeriodDaily, startDate: "2026-09-01", maxDays: 3,
+			want: "2026-10-17..2026-10-17 2026-10-18..2026-10-18 2026-10-19..2026-10-19",
+		},
+		{
+			name:   "weekly keeps the week overlapping max days",
+			period: PeriodWeekly, startDate: "2026-10-01", maxDays: 7,
+			want: "2026-10-08..2026-10-14 2026-10-15..2026-10-21",
+		},
+		{
+			name:   "not started",
+			period: PeriodDaily, startDate: "2026-10-20", maxDays: 30,
+		},
+		{
+			name:   "invalid start date",
+			period: PeriodDaily, startDate: "2026-13-01", maxDays: 30,
+			wantErr: true,
+		},
+		{
+			name:   "invalid end date",
+			period: PeriodDaily, startDate: "2026-10-01", endDate: "soon", maxDays: 30,
+			wantErr: true,
+		},
+	}
+
+	today := date(t, "2026-10-19")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := &health.HealthGoal{Period: tt.period, StartDate: tt.startDate, EndDate: tt.endDate}
+			periods, err := Periods(goal, today, tt.maxDays)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Periods() error = %v, want error %t", err, tt.wantErr)
+			}
+			if got := format(periods); got != tt.want {
+				t.Errorf("Periods() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
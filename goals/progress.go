@@ -0,0 +1,116 @@
+package goals
+
+import (
+	"math"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
+)
+
+// Directions a goal's target is met from.
+const (
+	DirectionAtLeast = "at_least"
+	DirectionAtMost  = "at_most"
+)
+
+// Outcomes of the period of a goal.
+const (
+	OutcomeAchieved = "achieved"
+	OutcomeMissed   = "missed"
+)
+
+// Totals sums the values of a goal's data per day, keyed by YYYY-MM-DD date.
+type Totals map[string]float64
+
+// Add adds value to the total of day.
+func (t Totals) Add(day time.Time, value float64) {
+	t[day.Format(dateLayout)] += value
+}
+
+// sum returns the total of the days of p.
+func (t Totals) sum(p summary.Period) float64 {
+	var sum float64
+	for day := p.Start; !day.After(p.End); day = day.AddDate(0, 0, 1) {
+		sum += t[day.Format(dateLayout)]
+	}
+	return sum
+}
+
+// Progress returns the progress of goal over periods as of today. Streaks only
+// count the periods given.
+func Progress(goal *health.HealthGoal, periods []summary.Period, totals Totals, today time.Time) *health.HealthGoalProgress {
+	progress := &health.HealthGoalProgress{Goal: goal, Periods: []*health.HealthGoalPeriod{}}
+
+	var streak int32
+	for _, p := range periods {
+		value := totals.sum(p)
+		period := &health.HealthGoalPeriod{
+			StartDate: p.Start.Format(dateLayout),
+			EndDate:   p.End.Format(dateLayout),
+			Value:     value,
+			Percent:   math.Round(value/goal.Target*10000) / 100,
+			Complete:  p.End.Before(today),
+		}
+
+		// An at_most goal is only met once its period is over
+		if goal.Direction == DirectionAtMost {
+			period.Achieved = period.Complete && value <= goal.Target
+		} else {
+			period.Achieved = value >= goal.Target
+		}
+
+		switch {
+		case period.Achieved:
+			streak++
+		case period.Complete:
+			streak = 0
+		}
+		progress.CurrentStreak = streak
+		progress.LongestStreak = max(progress.LongestStreak, streak)
+		progress.Periods = append(progress.Periods, period)
+	}
+
+	return progress
+}
+
+// Events returns the achieved or missed event of every complete period of
+// progress ending on or after since.
+func Events(progress *health.HealthGoalProgress, since time.Time, now time.Time) []*health.HealthGoalEvent {
+	goal := progress.Goal
+
+	var events []*health.HealthGoalEvent
+	var streak int32
+	for _, period := range progress.Periods {
+		if !period.Complete {
+			continue
+		}
+		outcome := OutcomeMissed
+		if period.Achieved {
+			outcome = OutcomeAchieved
+			streak++
+		} else {
+			streak = 0
+		}
+		if period.EndDate < since.Format(dateLayout) {
+			continue
+		}
+
+		events = append(events, &health.HealthGoalEvent{
+			Id:          goal.Id + ":" + period.StartDate,
+			GoalId:      goal.Id,
+			UserId:      goal.UserId,
+			Outcome:     outcome,
+			DataType:    goal.DataType,
+			Period:      goal.Period,
+			StartDate:   period.StartDate,
+			EndDate:     period.EndDate,
+			Value:       period.Value,
+			Target:      goal.Target,
+			Streak:      streak,
+			EvaluatedAt: now.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return events
+}
@@ -0,0 +1,153 @@
+package goals
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/summary"
+)
+
+// days returns the daily periods from start to end.
+func days(t *testing.T, start, end string) []summary.Period {
+	t.Helper()
+
+	return summary.Period{Start: date(t, start), End: date(t, end)}.Split(1)
+}
+
+// totals returns the totals of the days from start, one value per day.
+func totals(t *testing.T, start string, values ...float64) Totals {
+	t.Helper()
+
+	totals := Totals{}
+	for i, value := range values {
+		totals.Add(date(t, start).AddDate(0, 0, i), value)
+	}
+	return totals
+}
+
+func TestProgress(t *testing.T) {
+	tests := []struct {
+		name          string
+		direction     string
+		values        []float64
+		want          string
+		current, best int32
+	}{
+		{
+			name:      "at least",
+			direction: DirectionAtLeast,
+			values:    []float64{150, 50, 100, 120},
+			want:      "150%:achieved 50%:missed 100%:achieved 120%:achieved(open)",
+			current:   2, best: 2,
+		},
+		{
+			name:      "at least open period not met keeps the streak",
+			direction: DirectionAtLeast,
+			values:    []float64{100, 100, 100, 20},
+			want:      "100%:achieved 100%:achieved 100%:achieved 20%:missed(open)",
+			current:   3, best: 3,
+		},
+		{
+			name:      "at most is only met once complete",
+			direction: DirectionAtMost,
+			values:    []float64{150, 50, 100, 20},
+			want:      "150%:missed 50%:achieved 100%:achieved 20%:missed(open)",
+			current:   2, best: 2,
+		},
+		{
+			name:      "missed period resets the current streak",
+			direction: DirectionAtLeast,
+			values:    []float64{100, 100, 0, 0},
+			want:      "100%:achieved 100%:achieved 0%:missed 0%:missed(open)",
+			current:   0, best: 2,
+		},
+	}
+
+	today := date(t, "2026-10-19")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := &health.HealthGoal{Id: "g1", Target: 100, Period: PeriodDaily, Direction: tt.direction}
+			progress := Progress(goal, days(t, "2026-10-16", "2026-10-19"), totals(t, "2026-10-16", tt.values...), today)
+
+			parts := make([]string, len(progress.Periods))
+			for i, period := range progress.Periods {
+				outcome := OutcomeMissed
+				if period.Achieved {
+					outcome = OutcomeAchieved
+				}
+				parts[i] = fmt.Sprintf("%g%%:%s", period.Percent, outcome)
+				if !period.Complete {
+					parts[i] += "(open)"
+				}
+			}
+			if got := strings.Join(parts, " "); got != tt.want {
+				t.Errorf("Progress() periods = %q, want %q", got, tt.want)
+			}
+			if progress.CurrentStreak != tt.current || progress.LongestStreak != tt.best {
+				t.Errorf("Progress() streaks = %d/%d, want %d/%d", progress.CurrentStreak, progress.LongestStreak, tt.current, tt.best)
+			}
+		})
+	}
+}
+
+func TestProgressSumsWeeklyPeriods(t *testing.T) {
+	goal := &health.HealthGoal{Target: 3, Period: PeriodWeekly}
+	periods := []summary.Period{{Start: date(t, "2026-10-01"), End: date(t, "2026-10-07")}}
+
+	progress := Progress(goal, periods, totals(t, "2026-09-30", 5, 0.5, 0.5, 0, 0, 0, 0, 0, 5), date(t, "2026-10-19"))
+	period := progress.Periods[0]
+	if period.Value != 1 || period.Percent != 33.33 || period.Achieved || !period.Complete {
+		t.Errorf("Progress() period = %+v, want 1 (33.33%%) missed and complete", period)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	goal := &health.HealthGoal{Id: "g1", UserId: "u1", DataType: "steps", Target: 100, Period: PeriodDaily}
+	today := date(t, "2026-10-19")
+	progress := Progress(goal, days(t, "2026-10-14", "2026-10-19"), totals(t, "2026-10-14", 0, 100, 100, 100, 50, 100), today)
+	now := time.Date(2026, 10, 19, 8, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	tests := []struct {
+		name  string
+		since string
+		want  string
+	}{
+		{
+			name:  "every complete period",
+			since: "2026-10-01",
+			want:  "g1:2026-10-14=missed/0 g1:2026-10-15=achieved/1 g1:2026-10-16=achieved/2 g1:2026-10-17=achieved/3 g1:2026-10-18=missed/0",
+		},
+		{
+			name:  "streaks count the periods before since",
+			since: "2026-10-17",
+			want:  "g1:2026-10-17=achieved/3 g1:2026-10-18=missed/0",
+		},
+		{
+			name:  "none since today",
+			since: "2026-10-19",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := Events(progress, date(t, tt.since), now)
+
+			parts := make([]string, len(events))
+			for i, event := range events {
+				parts[i] = fmt.Sprintf("%s=%s/%d", event.Id, event.Outcome, event.Streak)
+				if event.GoalId != "g1" || event.UserId != "u1" || event.DataType != "steps" || event.Target != 100 {
+					t.Errorf("Events()[%d] = %+v, want the goal's fields", i, event)
+				}
+				if event.EvaluatedAt != "2026-10-19T06:30:00Z" {
+					t.Errorf("Events()[%d] evaluated at %q, want 2026-10-19T06:30:00Z", i, event.EvaluatedAt)
+				}
+			}
+			if got := strings.Join(parts, " "); got != tt.want {
+				t.Errorf("Events() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
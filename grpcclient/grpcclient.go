@@ -0,0 +1,113 @@
+package grpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/leastrequest"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // registers the client-side health checking function
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+)
+
+// staticScheme is the resolver scheme used when a list of addresses is configured.
+const staticScheme = "static"
+
+// Load balancing policies accepted in HEALTH_LB_POLICY.
+const (
+	PolicyRoundRobin   = "round_robin"
+	PolicyLeastRequest = "least_request"
+	PolicyPickFirst    = "pick_first"
+)
+
+// NewHealthClient creates a gRPC client connection to the health service.
+//
+// cfg.HealthSvcAddr may be a single address (":8082"), a comma separated list of
+// addresses ("health-1:8082,health-2:8082") or a resolver target such as
+// "dns:///health-service:8082". Calls are balanced across all resolved backends
+// and, when enabled, backends are health checked through grpc.health.v1.
+func NewHealthClient(cfg config.Config, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	serviceConfig, err := buildServiceConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	target, res := buildTarget(cfg.HealthSvcAddr)
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	}
+	if res != nil {
+		dialOpts = append(dialOpts, grpc.WithResolvers(res))
+	}
+	dialOpts = append(dialOpts, opts...)
+
+	conn, err := grpc.NewClient(target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create health service client: %w", err)
+	}
+
+	return conn, nil
+}
+
+// buildTarget converts the configured address into a gRPC target. A list of
+// addresses is served by a static manual resolver, anything else is handed to
+// gRPC's default resolvers unchanged.
+func buildTarget(addr string) (string, resolver.Builder) {
+	if strings.Contains(addr, "://") || !strings.Contains(addr, ",") {
+		return addr, nil
+	}
+
+	var addresses []resolver.Address
+	for _, a := range strings.Split(addr, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			addresses = append(addresses, resolver.Address{Addr: a})
+		}
+	}
+
+	res := manual.NewBuilderWithScheme(staticScheme)
+	res.InitialState(resolver.State{Addresses: addresses})
+
+	return staticScheme + ":///health-service", res
+}
+
+// buildServiceConfig renders the default service config for the configured
+// balancing policy and health checking settings.
+func buildServiceConfig(cfg config.Config) (string, error) {
+	var policy string
+	switch cfg.HealthSvcLBPolicy {
+	case "", PolicyRoundRobin:
+		policy = roundrobin.Name
+	case PolicyLeastRequest:
+		policy = leastrequest.Name
+	case PolicyPickFirst:
+		policy = PolicyPickFirst
+	default:
+		return "", fmt.Errorf("unsupported load balancing policy %q", cfg.HealthSvcLBPolicy)
+	}
+
+	serviceConfig := map[string]interface{}{
+		"loadBalancingConfig": []map[string]interface{}{
+			{policy: map[string]interface{}{}},
+		},
+	}
+	if cfg.HealthSvcHealthCheck {
+		serviceConfig["healthCheckConfig"] = map[string]interface{}{
+			"serviceName": cfg.HealthSvcHealthCheckName,
+		}
+	}
+
+	data, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal service config: %w", err)
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,114 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+)
+
+// Keys used for caller identity in gRPC metadata and Kafka headers.
+const (
+	UserIDKey    = "x-user-id"
+	UserRoleKey  = "x-user-role"
+	TokenIDKey   = "x-token-jti"
+	TimestampKey = "x-caller-timestamp"
+	SignatureKey = "x-caller-signature"
+)
+
+// Caller is the authenticated user on whose behalf the gateway acts.
+type Caller struct {
+	UserID  string
+	Role    string
+	TokenID string
+}
+
+// Field is a single identity key/value pair.
+type Field struct {
+	Key   string
+	Value string
+}
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying the caller.
+func NewContext(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, ctxKey{}, caller)
+}
+
+// FromContext returns the caller stored in ctx.
+func FromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(ctxKey{}).(Caller)
+	return caller, ok
+}
+
+// Signer produces the identity fields forwarded to downstream systems and,
+// when configured with a key, an HMAC-SHA256 signature over them so the health
+// service can verify they were set by the gateway.
+type Signer struct {
+	key []byte
+	now func() time.Time
+}
+
+// NewSigner creates a Signer. An empty key disables signing.
+func NewSigner(key string) *Signer {
+	return &Signer{key: []byte(key), now: time.Now}
+}
+
+// Fields returns the identity fields for the caller and request ID stored in
+// ctx, or nil for unauthenticated requests. The request ID itself is forwarded
+// by the requestid package; it is only covered by the signature here.
+func (s *Signer) Fields(ctx context.Context) []Field {
+	caller, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	fields := []Field{
+		{Key: UserIDKey, Value: caller.UserID},
+		{Key: UserRoleKey, Value: caller.Role},
+		{Key: TokenIDKey, Value: caller.TokenID},
+	}
+	if len(s.key) == 0 {
+		return fields
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	fields = append(fields,
+		Field{Key: TimestampKey, Value: timestamp},
+		Field{Key: SignatureKey, Value: s.sign(caller, requestid.FromContext(ctx), timestamp)},
+	)
+
+	return fields
+}
+
+// sign computes the signature over the canonical form
+// "user_id\nrole\njti\nrequest_id\ntimestamp".
+func (s *Signer) sign(caller Caller, requestID, timestamp string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(strings.Join([]string{caller.UserID, caller.Role, caller.TokenID, requestID, timestamp}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// UnaryClientInterceptor attaches the caller identity to every gRPC call as metadata.
+func UnaryClientInterceptor(signer *Signer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		fields := signer.Fields(ctx)
+		if len(fields) > 0 {
+			kv := make([]string, 0, len(fields)*2)
+			for _, f := range fields {
+				kv = append(kv, f.Key, f.Value)
+			}
+			ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
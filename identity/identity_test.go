@@ -0,0 +1,125 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+)
+
+// testSigner signs with key at a fixed time.
+func testSigner(key string) *Signer {
+	s := NewSigner(key)
+	s.now = func() time.Time { return time.Unix(1791100800, 0) }
+	return s
+}
+
+// wantSignature computes the signature the health service expects.
+func wantSignature(key, message string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestSignerFields(t *testing.T) {
+	caller := Caller{UserID: "u1", Role: "doctor", TokenID: "jti-1"}
+	ctx := requestid.NewContext(NewContext(context.Background(), caller), "req-42")
+
+	tests := []struct {
+		name string
+		key  string
+		ctx  context.Context
+		want []Field
+	}{
+		{name: "unauthenticated", key: "secret", ctx: requestid.NewContext(context.Background(), "req-42")},
+		{name: "unsigned", ctx: ctx, want: []Field{
+			{Key: UserIDKey, Value: "u1"},
+			{Key: UserRoleKey, Value: "doctor"},
+			{Key: TokenIDKey, Value: "jti-1"},
+		}},
+		{name: "signed", key: "secret", ctx: ctx, want: []Field{
+			{Key: UserIDKey, Value: "u1"},
+			{Key: UserRoleKey, Value: "doctor"},
+			{Key: TokenIDKey, Value: "jti-1"},
+			{Key: TimestampKey, Value: "1791100800"},
+			{Key: SignatureKey, Value: wantSignature("secret", "u1\ndoctor\njti-1\nreq-42\n1791100800")},
+		}},
+		{name: "signed without request id", key: "secret", ctx: NewContext(context.Background(), caller), want: []Field{
+			{Key: UserIDKey, Value: "u1"},
+			{Key: UserRoleKey, Value: "doctor"},
+			{Key: TokenIDKey, Value: "jti-1"},
+			{Key: TimestampKey, Value: "1791100800"},
+			{Key: SignatureKey, Value: wantSignature("secret", "u1\ndoctor\njti-1\n\n1791100800")},
+		}},
+	}
+
+	for _, tt := range tests {
+		if got := testSigner(tt.key).Fields(tt.ctx); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Fields() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSignatureCoversEveryField(t *testing.T) {
+	signature := func(caller Caller, requestID string) string {
+		ctx := requestid.NewContext(NewContext(context.Background(), caller), requestID)
+		fields := testSigner("secret").Fields(ctx)
+		return fields[len(fields)-1].Value
+	}
+
+	base := signature(Caller{UserID: "u1", Role: "user", TokenID: "jti-1"}, "req-42")
+	others := map[string]string{
+		"user id":    signature(Caller{UserID: "u2", Role: "user", TokenID: "jti-1"}, "req-42"),
+		"role":       signature(Caller{UserID: "u1", Role: "admin", TokenID: "jti-1"}, "req-42"),
+		"token id":   signature(Caller{UserID: "u1", Role: "user", TokenID: "jti-2"}, "req-42"),
+		"request id": signature(Caller{UserID: "u1", Role: "user", TokenID: "jti-1"}, "req-43"),
+	}
+	for name, other := range others {
+		if other == base {
+			t.Errorf("signature does not change with the %s", name)
+		}
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	caller := Caller{UserID: "u1", Role: "admin", TokenID: "jti-1"}
+
+	tests := []struct {
+		name string
+		key  string
+		ctx  context.Context
+		want metadata.MD
+	}{
+		{name: "unauthenticated", ctx: context.Background()},
+		{name: "unsigned", ctx: NewContext(context.Background(), caller), want: metadata.Pairs(
+			UserIDKey, "u1", UserRoleKey, "admin", TokenIDKey, "jti-1",
+		)},
+		{name: "signed", key: "secret", ctx: requestid.NewContext(NewContext(context.Background(), caller), "req-42"), want: metadata.Pairs(
+			UserIDKey, "u1", UserRoleKey, "admin", TokenIDKey, "jti-1",
+			TimestampKey, "1791100800",
+			SignatureKey, wantSignature("secret", "u1\nadmin\njti-1\nreq-42\n1791100800"),
+		)},
+	}
+
+	for _, tt := range tests {
+		var got metadata.MD
+		invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			got, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		}
+		if err := UnaryClientInterceptor(testSigner(tt.key))(tt.ctx, "/health.Service/Get", nil, nil, nil, invoker); err != nil {
+			t.Fatalf("%s: interceptor error = %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: metadata = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -3,15 +3,29 @@ package kafka
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/identity"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+	"github.com/health-analytics-service/api-gateway-health-analytics/precondition"
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+	"github.com/health-analytics-service/api-gateway-health-analytics/tracing"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Producer produces Kafka messages.
 type Producer struct {
 	writer *kafka.Writer
+	signer *identity.Signer
 	Cfg    config.Config
 }
 
@@ -23,28 +37,151 @@ func NewProducer(cfg config.Config) *Producer {
 		RequiredAcks:           kafka.RequireOne,
 		Balancer:               &kafka.LeastBytes{},
 	}
-	return &Producer{writer: writer, Cfg: cfg}
+	return &Producer{writer: writer, signer: identity.NewSigner(cfg.IdentitySigningKey), Cfg: cfg}
+}
+
+// Message is a message to produce, keyed for partitioning.
+type Message struct {
+	Key   string
+	Value interface{}
 }
 
 // ProduceMessage produces a message to the specified topic with the given key and value.
 func (p *Producer) ProduceMessage(ctx context.Context, topic, key string, message interface{}) error {
-	value, err := json.Marshal(message)
-	if err != nil {
-		return fmt.Errorf("failed to marshal message: %w", err)
+	return p.ProduceMessages(ctx, topic, Message{Key: key, Value: message})
+}
+
+// ProduceMessages produces messages to the specified topic in a single write,
+// so they share one batch instead of waiting on a batch each.
+func (p *Producer) ProduceMessages(ctx context.Context, topic string, messages ...Message) error {
+	values := make([][]byte, len(messages))
+	for i, message := range messages {
+		value, err := json.Marshal(message.Value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal message: %w", err)
+		}
+		values[i] = value
 	}
 
-	err = p.writer.WriteMessages(ctx, kafka.Message{
-		Topic: topic,
-		Key:   []byte(key),
-		Value: value,
-	})
+	attributes := []attribute.KeyValue{
+		semconv.MessagingSystemKafka,
+		semconv.MessagingDestinationName(topic),
+	}
+	if len(messages) == 1 {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(messages[0].Key))
+	} else {
+		attributes = append(attributes, semconv.MessagingBatchMessageCount(len(messages)))
+	}
+	ctx, span := tracing.Tracer().Start(ctx, "kafka.produce "+topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attributes...),
+	)
+	defer span.End()
+
+	// Inject the trace context so consumers can continue the trace
+	var headers []kafka.Header
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{headers: &headers})
+	if id := requestid.FromContext(ctx); id != "" {
+		headers = append(headers, kafka.Header{Key: requestid.KafkaHeader, Value: []byte(id)})
+	}
+	if version := precondition.FromContext(ctx); version != "" {
+		headers = append(headers, kafka.Header{Key: precondition.KafkaHeader, Value: []byte(version)})
+	}
+	for _, f := range p.signer.Fields(ctx) {
+		headers = append(headers, kafka.Header{Key: f.Key, Value: []byte(f.Value)})
+	}
+
+	var size int
+	msgs := make([]kafka.Message, len(messages))
+	for i, message := range messages {
+		size += len(values[i])
+		msgs[i] = kafka.Message{
+			Topic:   topic,
+			Key:     []byte(message.Key),
+			Value:   values[i],
+			Headers: headers,
+		}
+	}
+
+	start := time.Now()
+	err := p.writer.WriteMessages(ctx, msgs...)
+	metrics.ObserveKafkaProduce(topic, len(msgs), size, time.Since(start), err)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		attrs := []any{slog.String("topic", topic)}
+		if len(messages) == 1 {
+			attrs = append(attrs, slog.String("key", messages[0].Key))
+		} else {
+			attrs = append(attrs, slog.Int("messages", len(messages)))
+		}
+		attrs = append(attrs, slog.String("error", err.Error()))
+		slog.ErrorContext(ctx, "failed to produce kafka message", attrs...)
 		return fmt.Errorf("failed to write message to Kafka: %w", err)
 	}
 
 	return nil
 }
 
+// Close flushes pending messages and closes the underlying Kafka writer.
+func (p *Producer) Close() error {
+	if err := p.writer.Close(); err != nil {
+		return fmt.Errorf("failed to close Kafka writer: %w", err)
+	}
+	return nil
+}
+
+// CheckTopics verifies that at least one broker is reachable and returns the
+// number of partitions of each of the given topics.
+func (p *Producer) CheckTopics(ctx context.Context, topics ...string) (map[string]int, error) {
+	var errs []error
+	for _, broker := range p.Cfg.KafkaBrokers {
+		partitions, err := readPartitions(ctx, broker, topics)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("broker %s: %w", broker, err))
+			continue
+		}
+
+		counts := make(map[string]int, len(topics))
+		for _, topic := range topics {
+			counts[topic] = 0
+		}
+		for _, partition := range partitions {
+			counts[partition.Topic]++
+		}
+		for _, topic := range topics {
+			if counts[topic] == 0 {
+				return counts, fmt.Errorf("topic %s has no partitions", topic)
+			}
+		}
+		return counts, nil
+	}
+
+	return nil, fmt.Errorf("no kafka broker reachable: %w", errors.Join(errs...))
+}
+
+// readPartitions reads topic metadata from a single broker.
+func readPartitions(ctx context.Context, broker string, topics []string) ([]kafka.Partition, error) {
+	conn, err := kafka.DialContext(ctx, "tcp", broker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial broker: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	} else {
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+	}
+
+	partitions, err := conn.ReadPartitions(topics...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read topic metadata: %w", err)
+	}
+
+	return partitions, nil
+}
+
 // CreateTopic creates a Kafka topic if it doesn't exist.
 func CreateTopic(cfg config.Config, topic string) error {
 	// Check if the topic already exists (implementation omitted for brevity)
@@ -1,34 +1,127 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/health-analytics-service/api-gateway-health-analytics/api"
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/handlers"
+	"github.com/health-analytics-service/api-gateway-health-analytics/coalesce"
 	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/config/logger"
+	"github.com/health-analytics-service/api-gateway-health-analytics/grpcclient"
+	"github.com/health-analytics-service/api-gateway-health-analytics/identity"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+	"github.com/health-analytics-service/api-gateway-health-analytics/precondition"
+	"github.com/health-analytics-service/api-gateway-health-analytics/requestid"
+	"github.com/health-analytics-service/api-gateway-health-analytics/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	cfg := config.Load()
 
-	// gRPC connection to the health service
-	healthGrpcConn, err := grpc.NewClient(
-		cfg.HealthSvcAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	// Structured logging
+	log, err := logger.NewLogger(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer log.Close()
+	slog.SetDefault(log.Logger)
+
+	// Distributed tracing
+	shutdownTracing, err := tracing.Init(context.Background(), cfg)
+	if err != nil {
+		fatal(log.Logger, "failed to initialize tracing", err)
+	}
+
+	// gRPC connection to the health service. Coalescing follows the
+	// interceptors adding the caller's metadata, so every call carries that of
+	// the request making it, and precedes those measuring and logging, so only
+	// the calls reaching the health service are counted.
+	interceptors := []grpc.UnaryClientInterceptor{
+		requestid.UnaryClientInterceptor(),
+		precondition.UnaryClientInterceptor(),
+		identity.UnaryClientInterceptor(identity.NewSigner(cfg.IdentitySigningKey)),
+	}
+	if cfg.HealthSvcCoalesceReads {
+		interceptors = append(interceptors, coalesce.UnaryClientInterceptor())
+	}
+	interceptors = append(interceptors,
+		metrics.UnaryClientInterceptor(),
+		logger.UnaryClientInterceptor(log.Logger),
+	)
+	healthGrpcConn, err := grpcclient.NewHealthClient(
+		cfg,
+		grpc.WithChainUnaryInterceptor(interceptors...),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	)
 	if err != nil {
-		log.Fatalf("Failed to connect to health service: %v", err)
+		fatal(log.Logger, "failed to connect to health service", err)
 	}
-	defer healthGrpcConn.Close()
 
-	// Create router
-	router := api.NewRouter(healthGrpcConn)
+	// Create handlers and router
+	handler, err := handlers.NewHandler(healthGrpcConn, &cfg)
+	if err != nil {
+		fatal(log.Logger, "failed to create handlers", err)
+	}
+	router := api.NewRouter(&cfg, handler, log.Logger)
+
+	server := &http.Server{
+		Addr:    cfg.HTTPPort,
+		Handler: router,
+	}
 
 	// Start server
-	fmt.Printf("API Gateway server listening on port %s\n", cfg.HTTPPort)
-	if err := router.Run(cfg.HTTPPort); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	go func() {
+		log.Info("API Gateway server listening", slog.String("addr", cfg.HTTPPort))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal(log.Logger, "failed to start server", err)
+		}
+	}()
+
+	// Wait for a termination signal
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	<-ctx.Done()
+	stop()
+
+	// Report not-ready and give load balancers time to stop routing to us
+	log.Info("shutting down API Gateway server")
+	handler.ProbeHandler.MarkShuttingDown()
+	time.Sleep(time.Duration(cfg.ShutdownDelay) * time.Second)
+
+	// Stop accepting new requests and drain in-flight ones
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error("failed to drain HTTP server", slog.String("error", err.Error()))
+	}
+
+	// Flush pending Kafka messages, then release the gRPC connection
+	if err := handler.Close(); err != nil {
+		log.Error("failed to close Kafka producer and audit log", slog.String("error", err.Error()))
 	}
+	if err := healthGrpcConn.Close(); err != nil {
+		log.Error("failed to close health service connection", slog.String("error", err.Error()))
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Error("failed to flush traces", slog.String("error", err.Error()))
+	}
+
+	log.Info("API Gateway server stopped")
+}
+
+// fatal logs the error and terminates the process.
+func fatal(log *slog.Logger, msg string, err error) {
+	log.Error(msg, slog.String("error", err.Error()))
+	os.Exit(1)
 }
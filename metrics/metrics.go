@@ -0,0 +1,222 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "api_gateway"
+
+// unmatchedRoute labels requests that did not match any registered route, so
+// that arbitrary URLs cannot blow up label cardinality.
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total number of HTTP requests by route template and status.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by route template and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	grpcClientRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "requests_total",
+		Help:      "Total number of gRPC calls to the health service by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcClientCoalescedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "coalesced_requests_total",
+		Help:      "Total number of gRPC reads answered by an identical call already in flight, by method.",
+	}, []string{"method"})
+
+	grpcClientRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of gRPC calls to the health service by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	kafkaMessagesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "produced_messages_total",
+		Help:      "Total number of messages successfully produced per topic.",
+	}, []string{"topic"})
+
+	kafkaErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "produce_errors_total",
+		Help:      "Total number of failed produce calls per topic.",
+	}, []string{"topic"})
+
+	kafkaBatchSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "batch_size_messages",
+		Help:      "Number of messages written per produce call.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 8),
+	}, []string{"topic"})
+
+	kafkaBatchBytes = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "batch_size_bytes",
+		Help:      "Payload bytes written per produce call.",
+		Buckets:   prometheus.ExponentialBuckets(64, 4, 8),
+	}, []string{"topic"})
+
+	kafkaProduceDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "produce_duration_seconds",
+		Help:      "Latency of produce calls per topic.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"topic"})
+
+	rateLimitedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "rate_limited_requests_total",
+		Help:      "Total number of requests rejected by the rate limiter per route group and role.",
+	}, []string{"group", "role"})
+
+	wearableAlertsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "anomaly",
+		Name:      "alerts_total",
+		Help:      "Total number of wearable alerts raised by rule and severity.",
+	}, []string{"rule", "severity"})
+
+	recommendationRulesReloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "recommendation",
+		Name:      "rules_reloads_total",
+		Help:      "Total number of recommendation rules file loads by result (success or error).",
+	}, []string{"result"})
+
+	summaryCacheLookupsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "summary_cache",
+		Name:      "lookups_total",
+		Help:      "Total number of summary cache lookups by summary kind and result (hit, miss or error).",
+	}, []string{"kind", "result"})
+
+	summaryCacheInvalidationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "summary_cache",
+		Name:      "invalidations_total",
+		Help:      "Total number of summary cache invalidations by scope (user or all).",
+	}, []string{"scope"})
+)
+
+// Handler returns the HTTP handler exposing all registered metrics, including
+// the Go runtime and process collectors of the default registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// GinMiddleware records request counts and latencies labelled by the route
+// template (c.FullPath()) rather than the raw URL.
+func GinMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		code := strconv.Itoa(c.Writer.Status())
+
+		httpRequestsTotal.WithLabelValues(c.Request.Method, route, code).Inc()
+		httpRequestDuration.WithLabelValues(c.Request.Method, route, code).Observe(time.Since(start).Seconds())
+	}
+}
+
+// UnaryClientInterceptor records per-method metrics for gRPC calls issued by
+// the health.*Service clients.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		grpcClientRequestsTotal.WithLabelValues(method, status.Code(err).String()).Inc()
+		grpcClientRequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// ObserveCoalesced records a gRPC read answered by an identical call already in
+// flight.
+func ObserveCoalesced(method string) {
+	grpcClientCoalescedTotal.WithLabelValues(method).Inc()
+}
+
+// ObserveKafkaProduce records the outcome of a single produce call.
+func ObserveKafkaProduce(topic string, messages, bytes int, duration time.Duration, err error) {
+	kafkaProduceDuration.WithLabelValues(topic).Observe(duration.Seconds())
+	if err != nil {
+		kafkaErrorsTotal.WithLabelValues(topic).Inc()
+		return
+	}
+
+	kafkaMessagesTotal.WithLabelValues(topic).Add(float64(messages))
+	kafkaBatchSize.WithLabelValues(topic).Observe(float64(messages))
+	kafkaBatchBytes.WithLabelValues(topic).Observe(float64(bytes))
+}
+
+// ObserveRateLimited records a request rejected by the rate limiter.
+func ObserveRateLimited(group, role string) {
+	rateLimitedTotal.WithLabelValues(group, role).Inc()
+}
+
+// ObserveWearableAlert records an alert raised by the wearable anomaly rules.
+func ObserveWearableAlert(rule, severity string) {
+	wearableAlertsTotal.WithLabelValues(rule, severity).Inc()
+}
+
+// ObserveRecommendationRulesReload records a load of the recommendation rules
+// file.
+func ObserveRecommendationRulesReload(ok bool) {
+	result := "success"
+	if !ok {
+		result = "error"
+	}
+	recommendationRulesReloadsTotal.WithLabelValues(result).Inc()
+}
+
+// ObserveSummaryCacheLookup records a summary cache lookup.
+func ObserveSummaryCacheLookup(kind, result string) {
+	summaryCacheLookupsTotal.WithLabelValues(kind, result).Inc()
+}
+
+// ObserveSummaryCacheInvalidation records a summary cache invalidation.
+func ObserveSummaryCacheInvalidation(scope string) {
+	summaryCacheInvalidationsTotal.WithLabelValues(scope).Inc()
+}
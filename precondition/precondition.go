@@ -0,0 +1,90 @@
+package precondition
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataKey is the gRPC metadata key carrying the version a write expects
+	// to replace.
+	MetadataKey = "x-expected-version"
+	// KafkaHeader is the Kafka message header carrying the version an update
+	// expects to replace.
+	KafkaHeader = "x-expected-version"
+)
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying the expected version, the
+// updated_at of the record the client's If-Match matched.
+func NewContext(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, version)
+}
+
+// FromContext returns the expected version stored in ctx, or an empty string.
+func FromContext(ctx context.Context) string {
+	version, _ := ctx.Value(ctxKey{}).(string)
+	return version
+}
+
+// ETag returns the strong entity tag of a record version, or an empty string
+// when the record carries no version.
+func ETag(id, updatedAt string) string {
+	if updatedAt == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(id + "\n" + updatedAt))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// MatchStrong reports whether an If-Match header value matches etag, using the
+// strong comparison of RFC 9110: weak tags never match. "*" matches any
+// existing record.
+func MatchStrong(header, etag string) bool {
+	return match(header, etag, false)
+}
+
+// MatchWeak reports whether an If-None-Match header value matches etag, using
+// the weak comparison of RFC 9110.
+func MatchWeak(header, etag string) bool {
+	return match(header, etag, true)
+}
+
+func match(header, etag string, weak bool) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if etag == "" {
+			continue
+		}
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue
+			}
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryClientInterceptor forwards the expected version to the health service
+// as gRPC metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if version := FromContext(ctx); version != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, version)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,93 @@
+package precondition
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestETag(t *testing.T) {
+	etag := ETag("m1", "2026-10-01T10:00:00Z")
+	if len(etag) != 34 || etag[0] != '"' || etag[33] != '"' {
+		t.Errorf("ETag() = %s, want a quoted 32 digit hex tag", etag)
+	}
+
+	tests := []struct {
+		name          string
+		id, updatedAt string
+		want          string
+	}{
+		{name: "same version", id: "m1", updatedAt: "2026-10-01T10:00:00Z", want: etag},
+		{name: "no version", id: "m1", updatedAt: "", want: ""},
+	}
+	for _, tt := range tests {
+		if got := ETag(tt.id, tt.updatedAt); got != tt.want {
+			t.Errorf("%s: ETag(%q, %q) = %s, want %s", tt.name, tt.id, tt.updatedAt, got, tt.want)
+		}
+	}
+
+	if ETag("m1", "2026-10-01T10:00:01Z") == etag {
+		t.Error("ETag() is the same for another version")
+	}
+	if ETag("m2", "2026-10-01T10:00:00Z") == etag {
+		t.Error("ETag() is the same for another record")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	const etag = `"abc"`
+
+	tests := []struct {
+		name       string
+		header     string
+		etag       string
+		wantStrong bool
+		wantWeak   bool
+	}{
+		{name: "same tag", header: `"abc"`, etag: etag, wantStrong: true, wantWeak: true},
+		{name: "other tag", header: `"abd"`, etag: etag},
+		{name: "weak tag", header: `W/"abc"`, etag: etag, wantWeak: true},
+		{name: "list", header: `"x", W/"y" , "abc"`, etag: etag, wantStrong: true, wantWeak: true},
+		{name: "unquoted", header: `abc`, etag: etag},
+		{name: "wildcard", header: `*`, etag: etag, wantStrong: true, wantWeak: true},
+		{name: "wildcard without version", header: `*`, etag: "", wantStrong: true, wantWeak: true},
+		{name: "no version", header: `""`, etag: ""},
+	}
+
+	for _, tt := range tests {
+		if got := MatchStrong(tt.header, tt.etag); got != tt.wantStrong {
+			t.Errorf("%s: MatchStrong(%q, %q) = %t, want %t", tt.name, tt.header, tt.etag, got, tt.wantStrong)
+		}
+		if got := MatchWeak(tt.header, tt.etag); got != tt.wantWeak {
+			t.Errorf("%s: MatchWeak(%q, %q) = %t, want %t", tt.name, tt.header, tt.etag, got, tt.wantWeak)
+		}
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{name: "expected version", ctx: NewContext(context.Background(), "2026-10-01T10:00:00Z"), want: []string{"2026-10-01T10:00:00Z"}},
+		{name: "no precondition", ctx: context.Background()},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			got = md.Get(MetadataKey)
+			return nil
+		}
+		if err := UnaryClientInterceptor()(tt.ctx, "/health.Service/Update", nil, nil, nil, invoker); err != nil {
+			t.Fatalf("%s: interceptor error = %v", tt.name, err)
+		}
+		if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+			t.Errorf("%s: %s metadata = %v, want %v", tt.name, MetadataKey, got, tt.want)
+		}
+	}
+}
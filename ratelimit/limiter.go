@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+)
+
+// Backends accepted in RATE_LIMIT_BACKEND.
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+// Limiter applies the configured rules using a shared bucket store.
+type Limiter struct {
+	rules Rules
+	store Store
+
+	// apiKeys holds the hashes of the API keys clients are limited by
+	apiKeys map[string]bool
+}
+
+// NewLimiter creates a Limiter from cfg, or returns nil when rate limiting is
+// disabled.
+func NewLimiter(cfg config.Config) (*Limiter, error) {
+	if !cfg.RateLimitEnabled {
+		return nil, nil
+	}
+
+	rules, err := ParseRules(cfg.RateLimitRules)
+	if err != nil {
+		return nil, err
+	}
+
+	var store Store
+	switch cfg.RateLimitBackend {
+	case BackendMemory:
+		store = NewMemoryStore()
+	case BackendRedis:
+		store = NewRedisStore(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+	default:
+		return nil, fmt.Errorf("unsupported rate limit backend %q", cfg.RateLimitBackend)
+	}
+
+	apiKeys := make(map[string]bool)
+	for _, apiKey := range cfg.RateLimitAPIKeys {
+		if apiKey = strings.TrimSpace(apiKey); apiKey != "" {
+			apiKeys[hashKey(apiKey)] = true
+		}
+	}
+
+	return &Limiter{rules: rules, store: store, apiKeys: apiKeys}, nil
+}
+
+// Close releases the bucket store.
+func (l *Limiter) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.store.Close()
+}
+
+// hashKey returns the hex SHA-256 of an API key, so keys are neither kept nor
+// stored in the clear.
+func hashKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/api/response"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+)
+
+// APIKeyHeader identifies API clients that are not tied to a user. Only the
+// keys listed in RATE_LIMIT_API_KEYS are honored.
+const APIKeyHeader = "X-API-Key"
+
+// ClientGroup is the scope of the limit ClientMiddleware applies.
+const ClientGroup = "client"
+
+// ClientMiddleware limits all requests of a client, keyed by its API key when
+// it is a known one, else its IP. It runs before the authentication
+// middleware, so it also throttles unauthenticated floods such as token
+// guessing; unknown API keys never escape the limit of their IP.
+func ClientMiddleware(limiter *Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if limiter == nil {
+			c.Next()
+			return
+		}
+		limit(c, limiter, ClientGroup, "", limiter.clientKey(c))
+	}
+}
+
+// Middleware limits the requests of each authenticated user to a route group,
+// by the limit of their role. It must run after the authentication
+// middleware. When the store is unavailable requests are let through rather
+// than failing the gateway.
+func Middleware(limiter *Limiter, group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit(c, limiter, group, c.GetString("userRole"), "user:"+c.GetString("userID"))
+	}
+}
+
+// limit counts the request against the bucket of key in group.
+func limit(c *gin.Context, limiter *Limiter, group, role, key string) {
+	if limiter == nil {
+		c.Next()
+		return
+	}
+
+	limit, ok := limiter.rules.Lookup(group, role)
+	if !ok {
+		c.Next()
+		return
+	}
+
+	res, err := limiter.store.Take(c.Request.Context(), "ratelimit:"+group+":"+key, limit)
+	if err != nil {
+		slog.WarnContext(c.Request.Context(), "rate limit store unavailable",
+			slog.String("group", group),
+			slog.String("error", err.Error()),
+		)
+		c.Next()
+		return
+	}
+
+	header := c.Writer.Header()
+	header.Set("RateLimit-Policy", strconv.Itoa(limit.Count)+";w="+strconv.Itoa(int(limit.Period.Seconds()))+";burst="+strconv.Itoa(limit.Burst))
+	header.Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+	header.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	header.Set("RateLimit-Reset", seconds(res.Reset))
+
+	if !res.Allowed {
+		metrics.ObserveRateLimited(group, role)
+		header.Set("Retry-After", seconds(res.RetryAfter))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, response.ErrorBody(c, "Rate limit exceeded"))
+		return
+	}
+
+	c.Next()
+}
+
+// clientKey identifies the client a request is counted against before it is
+// authenticated.
+func (l *Limiter) clientKey(c *gin.Context) string {
+	if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" {
+		if hash := hashKey(apiKey); l.apiKeys[hash] {
+			return "key:" + hash
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// seconds formats d as whole seconds, rounded up.
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
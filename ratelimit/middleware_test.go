@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestClientMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := &Limiter{
+		rules: Rules{ClientGroup: {Count: 1, Period: time.Hour, Burst: 2}},
+		store: &MemoryStore{buckets: map[string]*bucket{}, now: time.Now},
+
+		apiKeys: map[string]bool{hashKey("k1"): true},
+	}
+	router := gin.New()
+	router.Use(ClientMiddleware(limiter))
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name       string
+		ip         string
+		apiKey     string
+		wantStatus int
+	}{
+		{name: "first from ip", ip: "10.0.0.1", wantStatus: http.StatusOK},
+		{name: "second from ip", ip: "10.0.0.1", wantStatus: http.StatusOK},
+		{name: "third from ip", ip: "10.0.0.1", wantStatus: http.StatusTooManyRequests},
+		{name: "other ip", ip: "10.0.0.2", wantStatus: http.StatusOK},
+		{name: "api key from limited ip", ip: "10.0.0.1", apiKey: "k1", wantStatus: http.StatusOK},
+		{name: "same api key from other ip", ip: "10.0.0.3", apiKey: "k1", wantStatus: http.StatusOK},
+		{name: "api key exhausted", ip: "10.0.0.4", apiKey: "k1", wantStatus: http.StatusTooManyRequests},
+		{name: "unknown api key from limited ip", ip: "10.0.0.1", apiKey: "guess", wantStatus: http.StatusTooManyRequests},
+		{name: "unknown api key from other ip", ip: "10.0.0.2", apiKey: "guess", wantStatus: http.StatusOK},
+		{name: "other unknown api key", ip: "10.0.0.2", apiKey: "guess2", wantStatus: http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.ip + ":1234"
+		if tt.apiKey != "" {
+			req.Header.Set(APIKeyHeader, tt.apiKey)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusTooManyRequests && rec.Header().Get("Retry-After") == "" {
+			t.Errorf("%s: Retry-After header missing", tt.name)
+		}
+	}
+}
+
+func TestMiddlewareWithoutLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware(nil, "sleep"))
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
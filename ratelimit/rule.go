@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// anyGroup matches every route group in a rule scope.
+const anyGroup = "*"
+
+// Limit is a token bucket: Burst tokens at most, refilled at Count tokens per
+// Period.
+type Limit struct {
+	Count  int
+	Period time.Duration
+	Burst  int
+}
+
+// Rate returns the refill rate in tokens per second.
+func (l Limit) Rate() float64 {
+	return float64(l.Count) / l.Period.Seconds()
+}
+
+// refill returns how long an empty bucket takes to fill up.
+func (l Limit) refill() time.Duration {
+	return time.Duration(float64(l.Burst) / l.Rate() * float64(time.Second))
+}
+
+// Rules maps a scope ("group", "group@role", "*" or "*@role") to its limit.
+type Rules map[string]Limit
+
+// ParseRules parses RATE_LIMIT_RULES, a semicolon separated list of
+// "scope=count/unit[:burst]" entries, e.g.
+//
+//	*=300/m:60;wearable-data=120/m:30;*@admin=1200/m
+//
+// Units are s, m and h. The burst defaults to count.
+func ParseRules(spec string) (Rules, error) {
+	rules := Rules{}
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		scope, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit rule %q: missing '='", entry)
+		}
+		limit, err := parseLimit(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit rule %q: %w", entry, err)
+		}
+		rules[strings.TrimSpace(scope)] = limit
+	}
+
+	return rules, nil
+}
+
+func parseLimit(value string) (Limit, error) {
+	rate, burst, hasBurst := strings.Cut(value, ":")
+	count, unit, ok := strings.Cut(rate, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("expected count/unit")
+	}
+
+	limit := Limit{}
+	var err error
+	if limit.Count, err = strconv.Atoi(count); err != nil || limit.Count <= 0 {
+		return Limit{}, fmt.Errorf("count must be a positive integer")
+	}
+	switch unit {
+	case "s":
+		limit.Period = time.Second
+	case "m":
+		limit.Period = time.Minute
+	case "h":
+		limit.Period = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("unknown unit %q", unit)
+	}
+
+	limit.Burst = limit.Count
+	if hasBurst {
+		if limit.Burst, err = strconv.Atoi(burst); err != nil || limit.Burst <= 0 {
+			return Limit{}, fmt.Errorf("burst must be a positive integer")
+		}
+	}
+
+	return limit, nil
+}
+
+// Lookup returns the most specific limit for a route group and role, in the
+// order group@role, group, *@role, *.
+func (r Rules) Lookup(group, role string) (Limit, bool) {
+	for _, scope := range []string{group + "@" + role, group, anyGroup + "@" + role, anyGroup} {
+		if limit, ok := r[scope]; ok {
+			return limit, true
+		}
+	}
+	return Limit{}, false
+}
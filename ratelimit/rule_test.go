@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    Rules
+		wantErr bool
+	}{
+		{
+			name: "defaults burst to count",
+			spec: "*=300/m",
+			want: Rules{"*": {Count: 300, Period: time.Minute, Burst: 300}},
+		},
+		{
+			name: "scopes and bursts",
+			spec: " client=1200/m:200; wearable-data=120/m:30 ;*@admin=10/s;",
+			want: Rules{
+				"client":        {Count: 1200, Period: time.Minute, Burst: 200},
+				"wearable-data": {Count: 120, Period: time.Minute, Burst: 30},
+				"*@admin":       {Count: 10, Period: time.Second, Burst: 10},
+			},
+		},
+		{name: "hours", spec: "*=5/h", want: Rules{"*": {Count: 5, Period: time.Hour, Burst: 5}}},
+		{name: "empty", spec: "", want: Rules{}},
+		{name: "missing equals", spec: "*300/m", wantErr: true},
+		{name: "missing unit", spec: "*=300", wantErr: true},
+		{name: "unknown unit", spec: "*=300/d", wantErr: true},
+		{name: "zero count", spec: "*=0/m", wantErr: true},
+		{name: "invalid burst", spec: "*=300/m:x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseRules(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRules(%q) error = %v, want error %t", tt.spec, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(rules) != len(tt.want) {
+				t.Fatalf("ParseRules(%q) = %v, want %v", tt.spec, rules, tt.want)
+			}
+			for scope, limit := range tt.want {
+				if rules[scope] != limit {
+					t.Errorf("ParseRules(%q)[%q] = %+v, want %+v", tt.spec, scope, rules[scope], limit)
+				}
+			}
+		})
+	}
+}
+
+func TestRulesLookup(t *testing.T) {
+	rules, err := ParseRules("*=1/m;*@admin=2/m;sleep=3/m;sleep@admin=4/m")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		group, role string
+		want        int
+	}{
+		{group: "sleep", role: "admin", want: 4},
+		{group: "sleep", role: "patient", want: 3},
+		{group: "heart-rate", role: "admin", want: 2},
+		{group: "heart-rate", role: "patient", want: 1},
+		{group: "heart-rate", role: "", want: 1},
+	}
+
+	for _, tt := range tests {
+		limit, ok := rules.Lookup(tt.group, tt.role)
+		if !ok || limit.Count != tt.want {
+			t.Errorf("Lookup(%q, %q) = %d, %t, want %d", tt.group, tt.role, limit.Count, ok, tt.want)
+		}
+	}
+
+	if _, ok := (Rules{"sleep": {Count: 1}}).Lookup("heart-rate", "admin"); ok {
+		t.Error("Lookup without a matching scope found a limit")
+	}
+}
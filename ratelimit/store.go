@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Result is the state of a bucket after taking a token from it.
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is how long until the next token is available when the
+	// request was rejected.
+	RetryAfter time.Duration
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+}
+
+// Store keeps token buckets.
+type Store interface {
+	// Take removes one token from the bucket under key, if there is one.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+	Close() error
+}
+
+// newResult derives the result from the tokens left in a bucket.
+func newResult(allowed bool, tokens float64, limit Limit) Result {
+	rate := limit.Rate()
+	res := Result{
+		Allowed:   allowed,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration((float64(limit.Burst) - tokens) / rate * float64(time.Second)),
+	}
+	if !allowed {
+		res.RetryAfter = time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+	return res
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// refill is how long an empty bucket takes to fill up.
+	refill time.Duration
+}
+
+// MemoryStore keeps buckets in process memory. Limits are enforced per
+// gateway instance.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+	done    chan struct{}
+}
+
+// sweepInterval is how often idle buckets are dropped from a MemoryStore.
+const sweepInterval = time.Minute
+
+// NewMemoryStore creates a MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	s := &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+		done:    make(chan struct{}),
+	}
+	go s.sweep()
+	return s
+}
+
+// Take implements Store.
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now, refill: limit.refill()}
+		s.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.last).Seconds()*limit.Rate())
+	b.last = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	return newResult(allowed, b.tokens, limit), nil
+}
+
+// sweep periodically removes buckets that have been idle long enough to refill
+// completely; recreating them later yields the same full bucket.
+func (s *MemoryStore) sweep() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			now := s.now()
+			for key, b := range s.buckets {
+				if now.Sub(b.last) > b.refill {
+					delete(s.buckets, key)
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// Close stops the background sweep.
+func (s *MemoryStore) Close() error {
+	close(s.done)
+	return nil
+}
@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreTake(t *testing.T) {
+	limit := Limit{Count: 60, Period: time.Minute, Burst: 2}
+
+	tests := []struct {
+		name string
+		// elapsed is the time since the previous take.
+		elapsed       time.Duration
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+	}{
+		{name: "full bucket", wantAllowed: true, wantRemaining: 1},
+		{name: "last token", wantAllowed: true, wantRemaining: 0},
+		{name: "empty bucket", wantAllowed: false, wantRemaining: 0, wantRetry: time.Second},
+		{name: "half refilled", elapsed: 500 * time.Millisecond, wantAllowed: false, wantRemaining: 0, wantRetry: 500 * time.Millisecond},
+		{name: "refilled", elapsed: 500 * time.Millisecond, wantAllowed: true, wantRemaining: 0},
+		{name: "refill capped at burst", elapsed: time.Hour, wantAllowed: true, wantRemaining: 1},
+	}
+
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	store := &MemoryStore{buckets: map[string]*bucket{}, now: func() time.Time { return now }}
+	for _, tt := range tests {
+		now = now.Add(tt.elapsed)
+		res, err := store.Take(context.Background(), "user:u1", limit)
+		if err != nil {
+			t.Fatalf("%s: Take: %v", tt.name, err)
+		}
+		if res.Allowed != tt.wantAllowed || res.Remaining != tt.wantRemaining || res.RetryAfter != tt.wantRetry {
+			t.Errorf("%s: Take() = %+v, want allowed %t, remaining %d, retry after %s", tt.name, res, tt.wantAllowed, tt.wantRemaining, tt.wantRetry)
+		}
+	}
+}
+
+func TestMemoryStoreKeysAreIndependent(t *testing.T) {
+	limit := Limit{Count: 1, Period: time.Hour, Burst: 1}
+	store := &MemoryStore{buckets: map[string]*bucket{}, now: time.Now}
+
+	for _, key := range []string{"user:u1", "user:u2", "ip:127.0.0.1"} {
+		if res, _ := store.Take(context.Background(), key, limit); !res.Allowed {
+			t.Errorf("first Take(%q) rejected", key)
+		}
+	}
+	if res, _ := store.Take(context.Background(), "user:u1", limit); res.Allowed {
+		t.Error("second Take(user:u1) allowed")
+	}
+}
@@ -0,0 +1,248 @@
+package recommend
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+)
+
+// ConditionResult is a condition evaluated against a summary.
+type ConditionResult struct {
+	Condition
+	// Actual is the value of the metric, nil when the summary lacks it.
+	Actual *float64 `json:"actual"`
+	Holds  bool     `json:"holds"`
+}
+
+// Evaluation is a rule evaluated against a summary.
+type Evaluation struct {
+	Rule       string            `json:"rule"`
+	Fired      bool              `json:"fired"`
+	Conditions []ConditionResult `json:"conditions"`
+	// Recommendation is set when the rule fired.
+	Recommendation *health.HealthRecommendation `json:"recommendation,omitempty"`
+}
+
+// Engine evaluates summaries against the rules file, reloading it when it
+// changes.
+type Engine struct {
+	path string
+
+	mu       sync.RWMutex
+	rules    *RuleSet
+	modified time.Time
+	loadedAt time.Time
+
+	done chan struct{}
+}
+
+// NewEngine creates an Engine with the rules file of cfg, or returns nil when
+// the recommendation engine is disabled.
+func NewEngine(cfg config.Config) (*Engine, error) {
+	if !cfg.RecommendationEngineEnabled {
+		return nil, nil
+	}
+
+	e := &Engine{path: cfg.RecommendationRulesPath, done: make(chan struct{})}
+	if err := e.Reload(); err != nil {
+		return nil, err
+	}
+
+	if interval := time.Duration(cfg.RecommendationRulesReload) * time.Second; interval > 0 {
+		go e.watch(interval)
+	}
+	return e, nil
+}
+
+// Rules returns the current rules and when they were loaded.
+func (e *Engine) Rules() (*RuleSet, time.Time) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.rules, e.loadedAt
+}
+
+// Reload reads the rules file again. The current rules are kept when the file
+// is invalid, until it changes again.
+func (e *Engine) Reload() error {
+	info, err := os.Stat(e.path)
+	if err != nil {
+		metrics.ObserveRecommendationRulesReload(false)
+		return err
+	}
+
+	rules, err := LoadRules(e.path)
+	e.mu.Lock()
+	e.modified = info.ModTime()
+	if err == nil {
+		e.rules, e.loadedAt = rules, time.Now()
+	}
+	e.mu.Unlock()
+	if err != nil {
+		metrics.ObserveRecommendationRulesReload(false)
+		return err
+	}
+
+	metrics.ObserveRecommendationRulesReload(true)
+	slog.Info("loaded recommendation rules",
+		slog.String("path", e.path),
+		slog.String("version", rules.Version),
+		slog.Int("rules", len(rules.Rules)),
+	)
+	return nil
+}
+
+// Evaluate evaluates the rules of period against the statistics of the
+// summary of the user from start to end, returning the evaluation of every
+// rule and the version of the rules used.
+func (e *Engine) Evaluate(userID, period string, start, end time.Time, stats *health.SummaryStatistics) ([]Evaluation, string) {
+	rules, _ := e.Rules()
+
+	evaluations := []Evaluation{}
+	for _, rule := range rules.Rules {
+		if rule.Period != period {
+			continue
+		}
+
+		evaluation := Evaluation{Rule: rule.Name, Fired: true}
+		for _, condition := range rule.Conditions {
+			result := ConditionResult{Condition: condition}
+			if actual, ok := metric(stats, condition.Metric); ok {
+				result.Actual = &actual
+				result.Holds = compare(actual, condition.Operator, condition.Value)
+			}
+			evaluation.Fired = evaluation.Fired && result.Holds
+			evaluation.Conditions = append(evaluation.Conditions, result)
+		}
+		if evaluation.Fired {
+			evaluation.Recommendation = rule.Recommendation.proto(userID)
+			evaluation.Recommendation.Id = RecommendationID(rule.Name, userID, start, end, rules.Version)
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+
+	return evaluations, rules.Version
+}
+
+// RecommendationID returns the ID of the recommendation of a rule for the
+// summary of the user from start to end, the same every time the same rules
+// version fires so that consumers can drop repeats.
+func RecommendationID(rule, userID string, start, end time.Time, version string) string {
+	name := strings.Join([]string{rule, userID, start.Format(time.DateOnly), end.Format(time.DateOnly), version}, "\x00")
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("recommendation:"+name)).String()
+}
+
+// Close stops watching the rules file.
+func (e *Engine) Close() error {
+	if e == nil {
+		return nil
+	}
+	close(e.done)
+	return nil
+}
+
+// watch reloads the rules file whenever its modification time changes.
+func (e *Engine) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.done:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(e.path)
+			e.mu.RLock()
+			changed := err == nil && !info.ModTime().Equal(e.modified)
+			e.mu.RUnlock()
+			if !changed {
+				continue
+			}
+			if err := e.Reload(); err != nil {
+				slog.Error("failed to reload recommendation rules, keeping the current version",
+					slog.String("path", e.path),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// metric returns the value of a metric path in stats.
+func metric(stats *health.SummaryStatistics, path string) (float64, bool) {
+	parts, err := splitMetric(path)
+	if err != nil {
+		return 0, false
+	}
+
+	switch parts[0] {
+	case "counts":
+		counts := stats.GetCounts()
+		switch parts[1] {
+		case "medical_records":
+			return float64(counts.GetMedicalRecords()), true
+		case "genetic_data":
+			return float64(counts.GetGeneticData()), true
+		case "lifestyle_data":
+			return float64(counts.GetLifestyleData()), true
+		case "wearable_data":
+			return float64(counts.GetWearableData()), true
+		case "health_recommendations":
+			return float64(counts.GetHealthRecommendations()), true
+		}
+		return 0, false
+	case "medical_record_types":
+		return float64(stats.GetMedicalRecordTypes()[parts[1]]), true
+	case "genetic_data_types":
+		return float64(stats.GetGeneticDataTypes()[parts[1]]), true
+	case "recommendation_types":
+		return float64(stats.GetRecommendationTypes()[parts[1]]), true
+	}
+
+	metrics := stats.GetLifestyleMetrics()
+	if parts[0] == "wearable" {
+		metrics = stats.GetWearableMetrics()
+	}
+	for _, m := range metrics {
+		if m.DataType != parts[1] {
+			continue
+		}
+		switch parts[2] {
+		case "count":
+			return float64(m.Count), true
+		case "min":
+			return m.Min, true
+		case "max":
+			return m.Max, true
+		case "avg":
+			return m.Avg, true
+		default:
+			return m.Sum, true
+		}
+	}
+	return 0, false
+}
+
+func compare(actual float64, operator string, value float64) bool {
+	switch operator {
+	case ">":
+		return actual > value
+	case ">=":
+		return actual >= value
+	case "<":
+		return actual < value
+	case "<=":
+		return actual <= value
+	case "==":
+		return actual == value
+	default:
+		return actual != value
+	}
+}
@@ -0,0 +1,160 @@
+package recommend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		actual   float64
+		operator string
+		value    float64
+		want     bool
+	}{
+		{actual: 2, operator: ">", value: 1, want: true},
+		{actual: 1, operator: ">", value: 1, want: false},
+		{actual: 1, operator: ">=", value: 1, want: true},
+		{actual: 0, operator: ">=", value: 1, want: false},
+		{actual: 0, operator: "<", value: 1, want: true},
+		{actual: 1, operator: "<", value: 1, want: false},
+		{actual: 1, operator: "<=", value: 1, want: true},
+		{actual: 2, operator: "<=", value: 1, want: false},
+		{actual: 1, operator: "==", value: 1, want: true},
+		{actual: 2, operator: "==", value: 1, want: false},
+		{actual: 2, operator: "!=", value: 1, want: true},
+		{actual: 1, operator: "!=", value: 1, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := compare(tt.actual, tt.operator, tt.value); got != tt.want {
+			t.Errorf("compare(%g %s %g) = %t, want %t", tt.actual, tt.operator, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMetric(t *testing.T) {
+	stats := &health.SummaryStatistics{
+		Counts:             &health.EntityCounts{WearableData: 12, HealthRecommendations: 2},
+		MedicalRecordTypes: map[string]int32{"lab": 3},
+		WearableMetrics: []*health.MetricStatistics{
+			{DataType: "steps", Count: 10, Min: 10, Max: 90, Avg: 43.7, Sum: 437},
+		},
+		LifestyleMetrics: []*health.MetricStatistics{
+			{DataType: "sleep", Count: 3, Avg: 21600000},
+		},
+	}
+
+	tests := []struct {
+		metric string
+		want   float64
+		wantOK bool
+	}{
+		{metric: "counts.wearable_data", want: 12, wantOK: true},
+		{metric: "counts.health_recommendations", want: 2, wantOK: true},
+		{metric: "counts.genetic_data", want: 0, wantOK: true},
+		{metric: "medical_record_types.lab", want: 3, wantOK: true},
+		{metric: "medical_record_types.visit", want: 0, wantOK: true},
+		{metric: "wearable.steps.sum", want: 437, wantOK: true},
+		{metric: "wearable.steps.count", want: 10, wantOK: true},
+		{metric: "wearable.steps.min", want: 10, wantOK: true},
+		{metric: "wearable.steps.max", want: 90, wantOK: true},
+		{metric: "wearable.steps.avg", want: 43.7, wantOK: true},
+		{metric: "lifestyle.sleep.avg", want: 21600000, wantOK: true},
+		{metric: "lifestyle.steps.sum"},
+		{metric: "wearable.heart_rate.avg"},
+		{metric: "wearable.steps"},
+	}
+
+	for _, tt := range tests {
+		got, ok := metric(stats, tt.metric)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("metric(%q) = %g, %t, want %g, %t", tt.metric, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	engine := &Engine{rules: &RuleSet{
+		Version: "2026.10.1",
+		Rules: []Rule{
+			{
+				Name:           "low_daily_activity",
+				Period:         PeriodDaily,
+				Conditions:     []Condition{{Metric: "wearable.steps.sum", Operator: "<", Value: 3000}},
+				Recommendation: Recommendation{RecommendationType: "exercise", Description: "Walk more.", Priority: 3},
+			},
+			{
+				Name:   "active_but_sleepless",
+				Period: PeriodDaily,
+				Conditions: []Condition{
+					{Metric: "wearable.steps.sum", Operator: ">=", Value: 10000},
+					{Metric: "lifestyle.sleep.sum", Operator: "<", Value: 21600000},
+				},
+				Recommendation: Recommendation{RecommendationType: "sleep", Description: "Sleep more.", Priority: 2},
+			},
+			{
+				Name:           "weekly_rule",
+				Period:         PeriodWeekly,
+				Conditions:     []Condition{{Metric: "wearable.steps.sum", Operator: "<", Value: 3000}},
+				Recommendation: Recommendation{RecommendationType: "exercise", Description: "Walk more.", Priority: 3},
+			},
+		},
+	}}
+	stats := &health.SummaryStatistics{
+		WearableMetrics: []*health.MetricStatistics{{DataType: "steps", Count: 10, Sum: 437}},
+	}
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	evaluations, version := engine.Evaluate("u1", PeriodDaily, day, day, stats)
+	if version != "2026.10.1" {
+		t.Errorf("Evaluate() version = %q, want 2026.10.1", version)
+	}
+	if len(evaluations) != 2 {
+		t.Fatalf("Evaluate() = %d evaluations, want the 2 daily rules", len(evaluations))
+	}
+
+	fired := evaluations[0]
+	if !fired.Fired || fired.Recommendation == nil || fired.Recommendation.UserId != "u1" || fired.Recommendation.RecommendationType != "exercise" {
+		t.Errorf("Evaluate()[0] = %+v, want low_daily_activity to fire for u1", fired)
+	}
+	if actual := fired.Conditions[0].Actual; actual == nil || *actual != 437 || !fired.Conditions[0].Holds {
+		t.Errorf("Evaluate()[0] condition = %+v, want 437 holding", fired.Conditions[0])
+	}
+
+	// A condition on a metric the summary lacks never holds
+	missing := evaluations[1]
+	if missing.Fired || missing.Recommendation != nil || missing.Conditions[1].Actual != nil || missing.Conditions[1].Holds {
+		t.Errorf("Evaluate()[1] = %+v, want active_but_sleepless not to fire", missing)
+	}
+}
+
+func TestRecommendationID(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	week := day.AddDate(0, 0, 6)
+	id := RecommendationID("low_daily_activity", "u1", day, week, "2026.10.1")
+
+	if again := RecommendationID("low_daily_activity", "u1", day, week, "2026.10.1"); again != id {
+		t.Errorf("RecommendationID() = %s then %s, want the same ID", id, again)
+	}
+
+	tests := []struct {
+		name                  string
+		rule, userID, version string
+		start, end            time.Time
+	}{
+		{name: "other rule", rule: "sleep_hygiene", userID: "u1", start: day, end: week, version: "2026.10.1"},
+		{name: "other user", rule: "low_daily_activity", userID: "u2", start: day, end: week, version: "2026.10.1"},
+		{name: "other start", rule: "low_daily_activity", userID: "u1", start: day.AddDate(0, 0, 1), end: week, version: "2026.10.1"},
+		{name: "other end", rule: "low_daily_activity", userID: "u1", start: day, end: week.AddDate(0, 0, -2), version: "2026.10.1"},
+		{name: "other version", rule: "low_daily_activity", userID: "u1", start: day, end: week, version: "2026.10.2"},
+		{name: "shifted separator", rule: "low_daily_activity", userID: "u1\x002026-10-01", start: day, end: week, version: "2026.10.1"},
+	}
+	for _, tt := range tests {
+		if got := RecommendationID(tt.rule, tt.userID, tt.start, tt.end, tt.version); got == id {
+			t.Errorf("%s: RecommendationID() = %s, want another ID", tt.name, got)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package recommend
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/validation"
+)
+
+// Summary periods rules apply to.
+const (
+	PeriodDaily  = "daily"
+	PeriodWeekly = "weekly"
+)
+
+// Condition compares a metric of the summary statistics to a value.
+//
+// Metrics are dotted paths into the statistics:
+//
+//	counts.<entity>                    records per entity, e.g. counts.wearable_data
+//	lifestyle.<data_type>.<stat>       count, min, max, avg or sum of numeric lifestyle values
+//	wearable.<data_type>.<stat>        same for wearable values
+//	medical_record_types.<type>        medical records of a record type
+//	genetic_data_types.<type>          genetic data of a data type
+//	recommendation_types.<type>        recommendations of a recommendation type
+type Condition struct {
+	Metric   string  `json:"metric"`
+	Operator string  `json:"operator"`
+	Value    float64 `json:"value"`
+}
+
+// Recommendation is the recommendation a rule produces.
+type Recommendation struct {
+	RecommendationType string `json:"recommendation_type"`
+	Description        string `json:"description"`
+	Priority           int32  `json:"priority"`
+}
+
+// Rule produces a recommendation when all its conditions hold for a summary
+// of its period.
+type Rule struct {
+	Name           string         `json:"name"`
+	Period         string         `json:"period"`
+	Conditions     []Condition    `json:"conditions"`
+	Recommendation Recommendation `json:"recommendation"`
+}
+
+// RuleSet is a version of the rules file.
+//
+//	{
+//	  "version": "2026.10.1",
+//	  "rules": [
+//	    {
+//	      "name": "sleep_hygiene",
+//	      "period": "weekly",
+//	      "conditions": [{"metric": "lifestyle.sleep.avg", "operator": "<", "value": 21600000}],
+//	      "recommendation": {"recommendation_type": "sleep", "description": "...", "priority": 2}
+//	    }
+//	  ]
+//	}
+type RuleSet struct {
+	Version string `json:"version"`
+	Rules   []Rule `json:"rules"`
+}
+
+// LoadRules reads a rules file.
+func LoadRules(path string) (*RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var set RuleSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid recommendation rules %s: %w", path, err)
+	}
+	if set.Version == "" {
+		return nil, fmt.Errorf("recommendation rules %s have no version", path)
+	}
+
+	names := make(map[string]bool, len(set.Rules))
+	for _, rule := range set.Rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("invalid recommendation rule %q: %w", rule.Name, err)
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("duplicate recommendation rule %q", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+
+	return &set, nil
+}
+
+func (r Rule) validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if r.Period != PeriodDaily && r.Period != PeriodWeekly {
+		return fmt.Errorf("period must be daily or weekly")
+	}
+	if len(r.Conditions) == 0 {
+		return fmt.Errorf("at least one condition is required")
+	}
+	for _, condition := range r.Conditions {
+		switch condition.Operator {
+		case ">", ">=", "<", "<=", "==", "!=":
+		default:
+			return fmt.Errorf("operator of %s must be >, >=, <, <=, == or !=", condition.Metric)
+		}
+		if _, err := splitMetric(condition.Metric); err != nil {
+			return err
+		}
+	}
+
+	// The recommendation must be publishable once the user is known
+	rec := r.Recommendation.proto("rule")
+	if errs := validation.HealthRecommendation(rec); len(errs) > 0 {
+		return fmt.Errorf("recommendation %s %s", errs[0].Field, errs[0].Message)
+	}
+	return nil
+}
+
+// proto returns the health recommendation for the user.
+func (r Recommendation) proto(userID string) *health.HealthRecommendation {
+	return &health.HealthRecommendation{
+		UserId:             userID,
+		RecommendationType: r.RecommendationType,
+		Description:        r.Description,
+		Priority:           r.Priority,
+	}
+}
+
+// splitMetric checks the shape of a metric path and returns its parts.
+func splitMetric(metric string) ([]string, error) {
+	parts := strings.Split(metric, ".")
+	switch {
+	case len(parts) == 2 && parts[0] == "counts",
+		len(parts) == 2 && parts[0] == "medical_record_types",
+		len(parts) == 2 && parts[0] == "genetic_data_types",
+		len(parts) == 2 && parts[0] == "recommendation_types":
+		return parts, nil
+	case len(parts) == 3 && (parts[0] == "lifestyle" || parts[0] == "wearable"):
+		switch parts[2] {
+		case "count", "min", "max", "avg", "sum":
+			return parts, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown metric %q", metric)
+}
@@ -0,0 +1,107 @@
+package recommend
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSplitMetric(t *testing.T) {
+	tests := []struct {
+		metric  string
+		want    []string
+		wantErr bool
+	}{
+		{metric: "counts.wearable_data", want: []string{"counts", "wearable_data"}},
+		{metric: "medical_record_types.lab", want: []string{"medical_record_types", "lab"}},
+		{metric: "genetic_data_types.snp", want: []string{"genetic_data_types", "snp"}},
+		{metric: "recommendation_types.sleep", want: []string{"recommendation_types", "sleep"}},
+		{metric: "lifestyle.sleep.avg", want: []string{"lifestyle", "sleep", "avg"}},
+		{metric: "wearable.steps.sum", want: []string{"wearable", "steps", "sum"}},
+		{metric: "wearable.heart_rate.count", want: []string{"wearable", "heart_rate", "count"}},
+		{metric: "wearable.steps.median", wantErr: true},
+		{metric: "wearable.steps", wantErr: true},
+		{metric: "counts", wantErr: true},
+		{metric: "counts.wearable_data.sum", wantErr: true},
+		{metric: "genetic.snp.count", wantErr: true},
+		{metric: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := splitMetric(tt.metric)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("splitMetric(%q) error = %v, want error %t", tt.metric, err, tt.wantErr)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("splitMetric(%q) = %v, want %v", tt.metric, got, tt.want)
+		}
+	}
+}
+
+func TestLoadRulesErrors(t *testing.T) {
+	const recommendation = `"recommendation": {"recommendation_type": "exercise", "description": "Walk more.", "priority": 3}`
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "invalid json", content: `{`, wantErr: "invalid recommendation rules"},
+		{name: "no version", content: `{"rules": []}`, wantErr: "have no version"},
+		{
+			name:    "unknown period",
+			content: `{"version": "1", "rules": [{"name": "r", "period": "monthly", "conditions": [{"metric": "counts.wearable_data", "operator": "<", "value": 1}], ` + recommendation + `}]}`,
+			wantErr: "period must be daily or weekly",
+		},
+		{
+			name:    "no conditions",
+			content: `{"version": "1", "rules": [{"name": "r", "period": "daily", ` + recommendation + `}]}`,
+			wantErr: "at least one condition is required",
+		},
+		{
+			name:    "unknown operator",
+			content: `{"version": "1", "rules": [{"name": "r", "period": "daily", "conditions": [{"metric": "counts.wearable_data", "operator": "=>", "value": 1}], ` + recommendation + `}]}`,
+			wantErr: "operator of counts.wearable_data must be",
+		},
+		{
+			name:    "unknown metric",
+			content: `{"version": "1", "rules": [{"name": "r", "period": "daily", "conditions": [{"metric": "wearable.steps", "operator": "<", "value": 1}], ` + recommendation + `}]}`,
+			wantErr: `unknown metric "wearable.steps"`,
+		},
+		{
+			name:    "invalid recommendation",
+			content: `{"version": "1", "rules": [{"name": "r", "period": "daily", "conditions": [{"metric": "counts.wearable_data", "operator": "<", "value": 1}], "recommendation": {"recommendation_type": "exercise", "priority": 3}}]}`,
+			wantErr: "recommendation description is required",
+		},
+		{
+			name: "duplicate",
+			content: `{"version": "1", "rules": [
+				{"name": "r", "period": "daily", "conditions": [{"metric": "counts.wearable_data", "operator": "<", "value": 1}], ` + recommendation + `},
+				{"name": "r", "period": "weekly", "conditions": [{"metric": "counts.wearable_data", "operator": "<", "value": 1}], ` + recommendation + `}
+			]}`,
+			wantErr: `duplicate recommendation rule "r"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rules.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadRules(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadRules() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadShippedRules(t *testing.T) {
+	if _, err := LoadRules("../config/recommendation/rules.json"); err != nil {
+		t.Errorf("LoadRules(config/recommendation/rules.json) = %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var gotContext, gotKey string
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/", func(c *gin.Context) {
+		gotContext = FromContext(c.Request.Context())
+		gotKey = c.GetString(ContextKey)
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "client id", header: "req-42", want: "req-42"},
+		{name: "client uuid", header: "0b5c0ad4-5e6f-4a45-9d1c-6b1f0b0d5e11", want: "0b5c0ad4-5e6f-4a45-9d1c-6b1f0b0d5e11"},
+		{name: "no id"},
+		{name: "space", header: "req 42"},
+		{name: "non ascii", header: "req-é"},
+		{name: "too long", header: strings.Repeat("r", maxLength+1)},
+	}
+
+	for _, tt := range tests {
+		gotContext, gotKey = "", ""
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if tt.header != "" {
+			req.Header.Set(Header, tt.header)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		id := rec.Header().Get(Header)
+		if tt.want != "" && id != tt.want {
+			t.Errorf("%s: %s = %q, want %q", tt.name, Header, id, tt.want)
+		}
+		if tt.want == "" {
+			if _, err := uuid.Parse(id); err != nil {
+				t.Errorf("%s: %s = %q, want a generated UUID", tt.name, Header, id)
+			}
+		}
+		if gotContext != id || gotKey != id {
+			t.Errorf("%s: context id = %q and gin key = %q, want %q", tt.name, gotContext, gotKey, id)
+		}
+	}
+}
+
+func TestMiddlewareGeneratesDistinctIDs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	seen := map[string]bool{}
+	for i := 0; i < 10; i++ {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		id := rec.Header().Get(Header)
+		if seen[id] {
+			t.Fatalf("%s %q generated twice", Header, id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{id: "", want: false},
+		{id: "a", want: true},
+		{id: "!~", want: true},
+		{id: strings.Repeat("r", maxLength), want: true},
+		{id: strings.Repeat("r", maxLength+1), want: false},
+		{id: "req\r\nX-Injected: 1", want: false},
+		{id: "req\t1", want: false},
+		{id: "req\x7f", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := valid(tt.id); got != tt.want {
+			t.Errorf("valid(%q) = %t, want %t", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{name: "request id", ctx: NewContext(context.Background(), "req-42"), want: []string{"req-42"}},
+		{name: "no request id", ctx: context.Background()},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			got = md.Get(MetadataKey)
+			return nil
+		}
+		if err := UnaryClientInterceptor()(tt.ctx, "/health.Service/Get", nil, nil, nil, invoker); err != nil {
+			t.Fatalf("%s: interceptor error = %v", tt.name, err)
+		}
+		if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+			t.Errorf("%s: %s metadata = %v, want %v", tt.name, MetadataKey, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package summary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"github.com/health-analytics-service/api-gateway-health-analytics/metrics"
+)
+
+// Backends accepted in SUMMARY_CACHE_BACKEND.
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+// Kinds of summaries, cached separately as the health service computes them
+// with different calls.
+const (
+	KindDaily  = "daily"
+	KindWeekly = "weekly"
+)
+
+// allUsers is the generation key invalidating the summaries of every user.
+const allUsers = "*"
+
+// Cache keeps the summaries fetched from the health service, keyed by kind,
+// user and period.
+//
+// Summaries are always kept in an in-process LRU, backed by a shared remote
+// store with the redis backend. Entries are invalidated by bumping a
+// generation per user, or for all users, which is part of every key: entries
+// of an older generation are never read again and expire on their own.
+type Cache struct {
+	local  *MemoryStore
+	remote Store
+	// generations holds the generation keys, in the remote store when there
+	// is one so that all gateway instances see the invalidations.
+	generations Store
+	// floor is the latest generation evicted from an in-process generations
+	// store, read in place of missing generations so that entries of an
+	// evicted one are never read again.
+	floor atomic.Int64
+
+	// ttl applies to summaries of periods that are not over yet, pastTTL to
+	// those of past periods, which no longer change.
+	ttl     time.Duration
+	pastTTL time.Duration
+	// settle is how long after an invalidation summaries of the user are only
+	// kept for ttl, while the health service consumes the published change.
+	settle time.Duration
+	now    func() time.Time
+}
+
+// NewCache creates a Cache from cfg, or returns nil when the summary cache is
+// disabled.
+func NewCache(cfg config.Config) (*Cache, error) {
+	if !cfg.SummaryCacheEnabled {
+		return nil, nil
+	}
+
+	cache := &Cache{
+		local:   NewMemoryStore(cfg.SummaryCacheSize),
+		ttl:     time.Duration(cfg.SummaryCacheTTL) * time.Second,
+		pastTTL: time.Duration(cfg.SummaryCachePastTTL) * time.Second,
+		settle:  time.Duration(cfg.SummaryCacheSettle) * time.Second,
+		now:     time.Now,
+	}
+
+	switch cfg.SummaryCacheBackend {
+	case BackendMemory:
+		generations := NewMemoryStore(cfg.SummaryCacheSize)
+		generations.evicted = cache.evict
+		cache.generations = generations
+	case BackendRedis:
+		cache.remote = NewRedisStore(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+		cache.generations = cache.remote
+	default:
+		return nil, fmt.Errorf("unsupported summary cache backend %q", cfg.SummaryCacheBackend)
+	}
+
+	return cache, nil
+}
+
+// Fetch returns the cached summary of kind for the user and period, or calls
+// fetch and caches its result. Cache failures are logged and fall back to
+// fetch.
+func (c *Cache) Fetch(ctx context.Context, kind, userID string, period Period, fetch func(context.Context) (*health.SummaryResponse, error)) (*health.SummaryResponse, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	// Read the generations before fetching, so that an invalidation racing
+	// with the fetch leaves the result under a stale key
+	global, user, err := c.generation(ctx, userID)
+	if err != nil {
+		c.fail(ctx, kind, "read", err)
+		return fetch(ctx)
+	}
+	key := fmt.Sprintf("summary:%s:%s:%s:%s:%d.%d", kind, url.PathEscape(userID),
+		period.Start.Format(time.DateOnly), period.End.Format(time.DateOnly), global, user)
+
+	value, ok, err := c.get(ctx, key)
+	if err != nil {
+		c.fail(ctx, kind, "read", err)
+		return fetch(ctx)
+	}
+	if ok {
+		resp := &health.SummaryResponse{}
+		if err := proto.Unmarshal(value, resp); err == nil {
+			metrics.ObserveSummaryCacheLookup(kind, "hit")
+			return resp, nil
+		}
+	}
+	metrics.ObserveSummaryCacheLookup(kind, "miss")
+
+	resp, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = proto.Marshal(resp)
+	if err == nil {
+		err = c.set(ctx, key, value, c.expiry(period, max(global, user)))
+	}
+	if err != nil {
+		c.fail(ctx, kind, "write", err)
+	}
+	return resp, nil
+}
+
+// Invalidate drops the cached summaries of the user, or of every user when
+// userID is empty.
+func (c *Cache) Invalidate(ctx context.Context, userID string) {
+	if c == nil {
+		return
+	}
+
+	scope := "user"
+	if userID == "" {
+		userID, scope = allUsers, "all"
+	}
+	metrics.ObserveSummaryCacheInvalidation(scope)
+
+	// Generations outlive every entry written under the previous one
+	generation := strconv.FormatInt(c.now().UnixNano(), 10)
+	if err := c.generations.Set(ctx, generationKey(userID), []byte(generation), c.pastTTL); err != nil {
+		slog.ErrorContext(ctx, "failed to invalidate summary cache",
+			slog.String("scope", scope),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// Close releases the stores of the cache.
+func (c *Cache) Close() error {
+	if c == nil {
+		return nil
+	}
+	return errors.Join(c.local.Close(), c.generations.Close())
+}
+
+// generation returns the current global and user generations, the times of
+// the last invalidations in nanoseconds.
+func (c *Cache) generation(ctx context.Context, userID string) (global, user int64, err error) {
+	if global, err = c.readGeneration(ctx, allUsers); err != nil {
+		return 0, 0, err
+	}
+	if user, err = c.readGeneration(ctx, userID); err != nil {
+		return 0, 0, err
+	}
+	return global, user, nil
+}
+
+func (c *Cache) readGeneration(ctx context.Context, userID string) (int64, error) {
+	value, ok, err := c.generations.Get(ctx, generationKey(userID))
+	if err != nil || !ok {
+		return c.floor.Load(), err
+	}
+	return strconv.ParseInt(string(value), 10, 64)
+}
+
+// evict raises the floor to a generation evicted from the generations store.
+func (c *Cache) evict(value []byte) {
+	generation, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return
+	}
+	for {
+		floor := c.floor.Load()
+		if generation <= floor || c.floor.CompareAndSwap(floor, generation) {
+			return
+		}
+	}
+}
+
+func generationKey(userID string) string {
+	return "summary-generation:" + url.PathEscape(userID)
+}
+
+func (c *Cache) get(ctx context.Context, key string) ([]byte, bool, error) {
+	if value, ok, _ := c.local.Get(ctx, key); ok || c.remote == nil {
+		return value, ok, nil
+	}
+
+	value, ok, err := c.remote.Get(ctx, key)
+	if ok {
+		c.local.Set(ctx, key, value, c.ttl)
+	}
+	return value, ok, err
+}
+
+func (c *Cache) set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.local.Set(ctx, key, value, ttl)
+	if c.remote == nil {
+		return nil
+	}
+	return c.remote.Set(ctx, key, value, ttl)
+}
+
+// expiry returns how long the summary of period stays cached, given the time
+// of the last invalidation affecting it.
+func (c *Cache) expiry(period Period, invalidated int64) time.Duration {
+	now := c.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if !period.End.Before(today) || now.Sub(time.Unix(0, invalidated)) < c.settle {
+		return c.ttl
+	}
+	return c.pastTTL
+}
+
+func (c *Cache) fail(ctx context.Context, kind, op string, err error) {
+	metrics.ObserveSummaryCacheLookup(kind, "error")
+	slog.WarnContext(ctx, "summary cache unavailable",
+		slog.String("kind", kind),
+		slog.String("op", op),
+		slog.String("error", err.Error()),
+	)
+}
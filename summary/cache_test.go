@@ -0,0 +1,146 @@
+package summary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/config"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// newTestCache creates an in-memory cache of size entries.
+func newTestCache(t *testing.T, size int) *Cache {
+	t.Helper()
+
+	cache, err := NewCache(config.Config{
+		SummaryCacheEnabled: true,
+		SummaryCacheBackend: BackendMemory,
+		SummaryCacheSize:    size,
+		SummaryCacheTTL:     60,
+		SummaryCachePastTTL: 86400,
+		SummaryCacheSettle:  30,
+	})
+	if err != nil {
+		t.Fatalf("NewCache: %v", err)
+	}
+	t.Cleanup(func() { cache.Close() })
+	return cache
+}
+
+// fetchCounter returns a fetch function and the number of times it was called.
+func fetchCounter() (func(context.Context) (*health.SummaryResponse, error), *int) {
+	calls := 0
+	return func(context.Context) (*health.SummaryResponse, error) {
+		calls++
+		return &health.SummaryResponse{}, nil
+	}, &calls
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	ctx := context.Background()
+	day := Period{Start: date(t, "2026-10-01"), End: date(t, "2026-10-01")}
+
+	tests := []struct {
+		name string
+		// invalidate is the user invalidated between the fetches, "" for all.
+		invalidate string
+		fetchUser  string
+		wantCalls  int
+	}{
+		{name: "same user", invalidate: "u1", fetchUser: "u1", wantCalls: 2},
+		{name: "other user", invalidate: "u2", fetchUser: "u1", wantCalls: 1},
+		{name: "every user", invalidate: "", fetchUser: "u1", wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newTestCache(t, 100)
+			fetch, calls := fetchCounter()
+
+			cache.Fetch(ctx, KindDaily, tt.fetchUser, day, fetch)
+			cache.Fetch(ctx, KindDaily, tt.fetchUser, day, fetch)
+			if *calls != 1 {
+				t.Fatalf("fetched %d times before invalidating, want 1", *calls)
+			}
+			time.Sleep(time.Millisecond)
+			cache.Invalidate(ctx, tt.invalidate)
+			cache.Fetch(ctx, KindDaily, tt.fetchUser, day, fetch)
+			if *calls != tt.wantCalls {
+				t.Errorf("fetched %d times, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCacheEvictedGenerations(t *testing.T) {
+	ctx := context.Background()
+	day := Period{Start: date(t, "2026-10-01"), End: date(t, "2026-10-01")}
+	cache := newTestCache(t, 2)
+	fetch, calls := fetchCounter()
+
+	// Cache u1, then invalidate it and let the generation store of two
+	// entries evict its generation
+	cache.Fetch(ctx, KindDaily, "u1", day, fetch)
+	time.Sleep(time.Millisecond)
+	cache.Invalidate(ctx, "u1")
+	cache.Invalidate(ctx, "u2")
+	cache.Invalidate(ctx, "u3")
+	if cache.floor.Load() == 0 {
+		t.Fatal("no generation was evicted")
+	}
+
+	cache.Fetch(ctx, KindDaily, "u1", day, fetch)
+	if *calls != 2 {
+		t.Errorf("fetched %d times, want the entry cached before the evicted invalidation to be missed", *calls)
+	}
+	cache.Fetch(ctx, KindDaily, "u1", day, fetch)
+	if *calls != 2 {
+		t.Errorf("fetched %d times, want the refetched entry to be cached", *calls)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	cache := newTestCache(t, 100)
+	cache.now = func() time.Time { return now }
+
+	tests := []struct {
+		name        string
+		end         string
+		invalidated time.Time
+		want        time.Duration
+	}{
+		{name: "period not over", end: "2026-10-19", want: time.Minute},
+		{name: "past period", end: "2026-10-18", want: 24 * time.Hour},
+		{name: "past period invalidated recently", end: "2026-10-18", invalidated: now.Add(-10 * time.Second), want: time.Minute},
+		{name: "past period invalidated long ago", end: "2026-10-18", invalidated: now.Add(-time.Minute), want: 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		period := Period{Start: date(t, "2026-10-12"), End: date(t, tt.end)}
+		var invalidated int64
+		if !tt.invalidated.IsZero() {
+			invalidated = tt.invalidated.UnixNano()
+		}
+		if got := cache.expiry(period, invalidated); got != tt.want {
+			t.Errorf("%s: expiry() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNilCacheFetches(t *testing.T) {
+	var cache *Cache
+	fetch, calls := fetchCounter()
+	day := Period{Start: date(t, "2026-10-01"), End: date(t, "2026-10-01")}
+
+	cache.Fetch(context.Background(), KindDaily, "u1", day, fetch)
+	cache.Fetch(context.Background(), KindDaily, "u1", day, fetch)
+	cache.Invalidate(context.Background(), "u1")
+	if *calls != 2 {
+		t.Errorf("fetched %d times, want 2", *calls)
+	}
+	if err := cache.Close(); err != nil {
+		t.Errorf("Close() = %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package summary
+
+import (
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// Merge appends the records of src to dst, skipping those dst already holds.
+// Summaries of adjacent periods can both hold records that are not bound to a
+// date, such as recommendations.
+func Merge(dst, src *health.SummaryResponse) {
+	dst.MedicalRecords = appendNew(dst.MedicalRecords, src.MedicalRecords)
+	dst.GeneticData = appendNew(dst.GeneticData, src.GeneticData)
+	dst.LifestyleData = appendNew(dst.LifestyleData, src.LifestyleData)
+	dst.WearableData = appendNew(dst.WearableData, src.WearableData)
+	dst.HealthRecommendations = appendNew(dst.HealthRecommendations, src.HealthRecommendations)
+}
+
+// appendNew appends the records of src whose ID is not in dst. Records
+// without an ID are always appended.
+func appendNew[T interface{ GetId() string }](dst, src []T) []T {
+	seen := make(map[string]bool, len(dst))
+	for _, record := range dst {
+		seen[record.GetId()] = true
+	}
+	for _, record := range src {
+		if id := record.GetId(); id == "" || !seen[id] {
+			seen[id] = true
+			dst = append(dst, record)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,64 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst, src []string
+		want     string
+	}{
+		{name: "into empty", dst: nil, src: []string{"r1", "r2"}, want: "r1,r2"},
+		{name: "disjoint", dst: []string{"r1"}, src: []string{"r2"}, want: "r1,r2"},
+		{name: "overlapping", dst: []string{"r1", "r2"}, src: []string{"r2", "r3"}, want: "r1,r2,r3"},
+		{name: "repeated in src", dst: nil, src: []string{"r1", "r1"}, want: "r1"},
+		{name: "without IDs", dst: []string{""}, src: []string{"", ""}, want: ",,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := &health.SummaryResponse{}
+			for _, id := range tt.dst {
+				dst.HealthRecommendations = append(dst.HealthRecommendations, &health.HealthRecommendation{Id: id})
+			}
+			src := &health.SummaryResponse{}
+			for _, id := range tt.src {
+				src.HealthRecommendations = append(src.HealthRecommendations, &health.HealthRecommendation{Id: id})
+			}
+
+			Merge(dst, src)
+
+			var got []string
+			for _, recommendation := range dst.HealthRecommendations {
+				got = append(got, recommendation.Id)
+			}
+			if strings.Join(got, ",") != tt.want {
+				t.Errorf("Merge() = %q, want %q", strings.Join(got, ","), tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeEveryEntity(t *testing.T) {
+	dst := &health.SummaryResponse{}
+	src := &health.SummaryResponse{
+		MedicalRecords:        []*health.MedicalRecord{{Id: "m1"}},
+		GeneticData:           []*health.GeneticData{{Id: "g1"}},
+		LifestyleData:         []*health.LifestyleData{{Id: "l1"}},
+		WearableData:          []*health.WearableData{{Id: "w1"}},
+		HealthRecommendations: []*health.HealthRecommendation{{Id: "h1"}},
+	}
+
+	Merge(dst, src)
+	Merge(dst, src)
+
+	counts := Statistics(dst).Counts
+	if counts.MedicalRecords != 1 || counts.GeneticData != 1 || counts.LifestyleData != 1 || counts.WearableData != 1 || counts.HealthRecommendations != 1 {
+		t.Errorf("Merge() twice counts = %v, want one of each", counts)
+	}
+}
@@ -0,0 +1,38 @@
+package summary
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+)
+
+// Invalidation invalidates the cached summaries of the patient whose data a
+// request created, updated or deleted. Handlers resolve the patient of writes
+// by ID from the stored record; a write without a known patient is logged and
+// never flushes the summaries of every user.
+func Invalidation(cache *Cache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return
+		}
+		if c.Writer.Status() >= http.StatusMultipleChoices {
+			return
+		}
+
+		patientID := audit.PatientID(c)
+		if patientID == "" {
+			slog.WarnContext(c.Request.Context(), "write without a known patient left the summary cache as is",
+				slog.String("route", c.FullPath()),
+			)
+			return
+		}
+		cache.Invalidate(c.Request.Context(), patientID)
+	}
+}
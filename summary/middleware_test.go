@@ -0,0 +1,60 @@
+package summary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/audit"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestInvalidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	day := Period{Start: date(t, "2026-10-01"), End: date(t, "2026-10-01")}
+
+	tests := []struct {
+		name      string
+		method    string
+		patient   string
+		status    int
+		wantCalls map[string]int
+	}{
+		{name: "write of a patient", method: http.MethodDelete, patient: "u1", status: http.StatusNoContent, wantCalls: map[string]int{"u1": 2, "u2": 1}},
+		{name: "write of an unknown patient", method: http.MethodDelete, status: http.StatusNoContent, wantCalls: map[string]int{"u1": 1, "u2": 1}},
+		{name: "failed write", method: http.MethodPut, patient: "u1", status: http.StatusBadRequest, wantCalls: map[string]int{"u1": 1, "u2": 1}},
+		{name: "read", method: http.MethodGet, patient: "u1", status: http.StatusOK, wantCalls: map[string]int{"u1": 1, "u2": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cache := newTestCache(t, 100)
+			fetches := map[string]func(context.Context) (*health.SummaryResponse, error){}
+			calls := map[string]*int{}
+			for _, user := range []string{"u1", "u2"} {
+				fetches[user], calls[user] = fetchCounter()
+				cache.Fetch(ctx, KindDaily, user, day, fetches[user])
+			}
+			time.Sleep(time.Millisecond)
+
+			router := gin.New()
+			router.Handle(tt.method, "/records/:id", Invalidation(cache), func(c *gin.Context) {
+				audit.SetPatient(c, tt.patient)
+				c.Status(tt.status)
+			})
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, "/records/r1", nil))
+
+			for user, want := range tt.wantCalls {
+				cache.Fetch(ctx, KindDaily, user, day, fetches[user])
+				if *calls[user] != want {
+					t.Errorf("%s fetched %d times, want %d", user, *calls[user], want)
+				}
+			}
+		})
+	}
+}
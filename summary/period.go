@@ -0,0 +1,38 @@
+package summary
+
+import (
+	"time"
+)
+
+// WeekDays is the longest period a weekly summary covers.
+const WeekDays = 7
+
+// Period is an inclusive range of days.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Days returns the number of days in p.
+func (p Period) Days() int {
+	return int(p.End.Sub(p.Start).Hours()/24) + 1
+}
+
+// Month returns the period of the calendar month holding t.
+func Month(t time.Time) Period {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return Period{Start: start, End: start.AddDate(0, 1, -1)}
+}
+
+// Split cuts p into consecutive periods of at most days days.
+func (p Period) Split(days int) []Period {
+	var periods []Period
+	for start := p.Start; !start.After(p.End); start = start.AddDate(0, 0, days) {
+		end := start.AddDate(0, 0, days-1)
+		if end.After(p.End) {
+			end = p.End
+		}
+		periods = append(periods, Period{Start: start, End: end})
+	}
+	return periods
+}
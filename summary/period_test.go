@@ -0,0 +1,88 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// date parses a YYYY-MM-DD date.
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	d, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestPeriodSplit(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		days       int
+		want       string
+	}{
+		{name: "single day", start: "2026-10-01", end: "2026-10-01", days: WeekDays, want: "2026-10-01..2026-10-01"},
+		{name: "exact week", start: "2026-10-01", end: "2026-10-07", days: WeekDays, want: "2026-10-01..2026-10-07"},
+		{name: "trailing partial week", start: "2026-10-01", end: "2026-10-10", days: WeekDays, want: "2026-10-01..2026-10-07 2026-10-08..2026-10-10"},
+		{
+			name:  "month",
+			start: "2026-02-01", end: "2026-02-28", days: WeekDays,
+			want: "2026-02-01..2026-02-07 2026-02-08..2026-02-14 2026-02-15..2026-02-21 2026-02-22..2026-02-28",
+		},
+		{name: "across a year", start: "2026-12-30", end: "2027-01-02", days: 2, want: "2026-12-30..2026-12-31 2027-01-01..2027-01-02"},
+		{name: "end before start", start: "2026-10-02", end: "2026-10-01", days: WeekDays, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period := Period{Start: date(t, tt.start), End: date(t, tt.end)}
+			var got []string
+			for _, p := range period.Split(tt.days) {
+				got = append(got, p.Start.Format(time.DateOnly)+".."+p.End.Format(time.DateOnly))
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Errorf("Split(%d) = %q, want %q", tt.days, strings.Join(got, " "), tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodDays(t *testing.T) {
+	tests := []struct {
+		start, end string
+		want       int
+	}{
+		{start: "2026-10-01", end: "2026-10-01", want: 1},
+		{start: "2026-10-01", end: "2026-10-07", want: 7},
+		{start: "2026-01-01", end: "2026-12-31", want: 365},
+		{start: "2028-01-01", end: "2028-12-31", want: 366},
+	}
+
+	for _, tt := range tests {
+		if got := (Period{Start: date(t, tt.start), End: date(t, tt.end)}).Days(); got != tt.want {
+			t.Errorf("Days(%s..%s) = %d, want %d", tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestMonth(t *testing.T) {
+	tests := []struct {
+		t          time.Time
+		start, end string
+	}{
+		{t: time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC), start: "2026-10-01", end: "2026-10-31"},
+		{t: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), start: "2026-02-01", end: "2026-02-28"},
+		{t: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), start: "2028-02-01", end: "2028-02-29"},
+		{t: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), start: "2026-12-01", end: "2026-12-31"},
+	}
+
+	for _, tt := range tests {
+		got := Month(tt.t)
+		if got.Start.Format(time.DateOnly) != tt.start || got.End.Format(time.DateOnly) != tt.end {
+			t.Errorf("Month(%s) = %s..%s, want %s..%s", tt.t, got.Start.Format(time.DateOnly), got.End.Format(time.DateOnly), tt.start, tt.end)
+		}
+	}
+}
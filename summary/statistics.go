@@ -0,0 +1,84 @@
+package summary
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Statistics derives the counts and metric statistics of a summary.
+func Statistics(resp *health.SummaryResponse) *health.SummaryStatistics {
+	stats := &health.SummaryStatistics{
+		Counts: &health.EntityCounts{
+			MedicalRecords:        int32(len(resp.MedicalRecords)),
+			GeneticData:           int32(len(resp.GeneticData)),
+			LifestyleData:         int32(len(resp.LifestyleData)),
+			WearableData:          int32(len(resp.WearableData)),
+			HealthRecommendations: int32(len(resp.HealthRecommendations)),
+		},
+		MedicalRecordTypes:  make(map[string]int32),
+		GeneticDataTypes:    make(map[string]int32),
+		RecommendationTypes: make(map[string]int32),
+	}
+
+	for _, record := range resp.MedicalRecords {
+		stats.MedicalRecordTypes[record.RecordType]++
+	}
+	for _, data := range resp.GeneticData {
+		stats.GeneticDataTypes[data.DataType]++
+	}
+	for _, recommendation := range resp.HealthRecommendations {
+		stats.RecommendationTypes[recommendation.RecommendationType]++
+	}
+
+	lifestyle := metricSet{}
+	for _, data := range resp.LifestyleData {
+		lifestyle.add(data.DataType, data.DataValue)
+	}
+	stats.LifestyleMetrics = lifestyle.statistics()
+
+	wearable := metricSet{}
+	for _, data := range resp.WearableData {
+		wearable.add(data.DataType, data.DataValue)
+	}
+	stats.WearableMetrics = wearable.statistics()
+
+	return stats
+}
+
+// metricSet accumulates the numeric values of records by data type.
+type metricSet map[string]*health.MetricStatistics
+
+func (m metricSet) add(dataType string, value *anypb.Any) {
+	number, ok := datavalue.Number(value)
+	if !ok {
+		return
+	}
+
+	metric, ok := m[dataType]
+	if !ok {
+		metric = &health.MetricStatistics{DataType: dataType, Min: math.Inf(1), Max: math.Inf(-1)}
+		m[dataType] = metric
+	}
+	metric.Count++
+	metric.Sum += number
+	metric.Min = min(metric.Min, number)
+	metric.Max = max(metric.Max, number)
+}
+
+// statistics returns the accumulated metrics sorted by data type.
+func (m metricSet) statistics() []*health.MetricStatistics {
+	var stats []*health.MetricStatistics
+	for _, metric := range m {
+		metric.Avg = metric.Sum / float64(metric.Count)
+		stats = append(stats, metric)
+	}
+	slices.SortFunc(stats, func(a, b *health.MetricStatistics) int {
+		return strings.Compare(a.DataType, b.DataType)
+	})
+	return stats
+}
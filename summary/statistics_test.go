@@ -0,0 +1,62 @@
+package summary
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/datavalue"
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestStatistics(t *testing.T) {
+	wearable := func(dataType, raw string) *health.WearableData {
+		value, err := datavalue.Decode(json.RawMessage(raw), dataType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &health.WearableData{DataType: dataType, DataValue: value}
+	}
+
+	stats := Statistics(&health.SummaryResponse{
+		MedicalRecords: []*health.MedicalRecord{{RecordType: "lab"}, {RecordType: "lab"}, {RecordType: "visit"}},
+		WearableData: []*health.WearableData{
+			wearable("steps", `4000`),
+			wearable("heart_rate", `{"heart_rate":80}`),
+			wearable("steps", `{"value":6000}`),
+			wearable("heart_rate", `{"heart_rate":60}`),
+			wearable("blood_pressure", `{"systolic":120}`),
+		},
+		HealthRecommendations: []*health.HealthRecommendation{{RecommendationType: "exercise"}},
+	})
+
+	if stats.Counts.MedicalRecords != 3 || stats.Counts.WearableData != 5 || stats.Counts.HealthRecommendations != 1 {
+		t.Errorf("Counts = %v", stats.Counts)
+	}
+	if stats.MedicalRecordTypes["lab"] != 2 || stats.MedicalRecordTypes["visit"] != 1 {
+		t.Errorf("MedicalRecordTypes = %v", stats.MedicalRecordTypes)
+	}
+	if stats.RecommendationTypes["exercise"] != 1 {
+		t.Errorf("RecommendationTypes = %v", stats.RecommendationTypes)
+	}
+
+	tests := []struct {
+		dataType           string
+		count              int32
+		min, max, avg, sum float64
+	}{
+		{dataType: "heart_rate", count: 2, min: 60, max: 80, avg: 70, sum: 140},
+		{dataType: "steps", count: 2, min: 4000, max: 6000, avg: 5000, sum: 10000},
+	}
+	if len(stats.WearableMetrics) != len(tests) {
+		t.Fatalf("WearableMetrics = %v, want %d metrics sorted by data type", stats.WearableMetrics, len(tests))
+	}
+	for i, tt := range tests {
+		m := stats.WearableMetrics[i]
+		if m.DataType != tt.dataType || m.Count != tt.count || m.Min != tt.min || m.Max != tt.max || m.Avg != tt.avg || m.Sum != tt.sum {
+			t.Errorf("WearableMetrics[%d] = %v, want %+v", i, m, tt)
+		}
+	}
+	if len(stats.LifestyleMetrics) != 0 {
+		t.Errorf("LifestyleMetrics = %v, want none", stats.LifestyleMetrics)
+	}
+}
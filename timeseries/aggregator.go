@@ -0,0 +1,141 @@
+package timeseries
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// maxUTCOffset is the largest offset a timestamp can carry, UTC+14:00.
+const maxUTCOffset = 14 * time.Hour
+
+// Aggregator rolls samples up into buckets. Samples must be added in the order
+// of their timestamps as written, such as ordered by recorded_timestamp, so
+// their instants may only go back by the difference of their offsets. A bucket
+// is emitted once a later sample proves no further sample can fall into it, so
+// only the buckets of the last day or so are held in memory.
+type Aggregator struct {
+	size      BucketSize
+	loc       *time.Location
+	functions []Function
+	emit      func(*health.WearableDataBucket) error
+
+	// cursor is the latest wall clock added, read as UTC
+	cursor time.Time
+	// buckets holds the values of the open buckets, keyed by their start
+	buckets map[time.Time][]float64
+	// next is the end of the earliest open bucket
+	next time.Time
+}
+
+// NewAggregator creates an Aggregator computing functions over buckets of
+// size aligned to loc, passing every closed bucket to emit.
+func NewAggregator(size BucketSize, loc *time.Location, functions []Function, emit func(*health.WearableDataBucket) error) *Aggregator {
+	return &Aggregator{
+		size:      size,
+		loc:       loc,
+		functions: functions,
+		emit:      emit,
+		buckets:   make(map[time.Time][]float64),
+	}
+}
+
+// Add adds the sample value taken at t and emits the buckets it closes.
+func (a *Aggregator) Add(t time.Time, value float64) error {
+	if t.Before(a.closed()) {
+		return fmt.Errorf("sample at %s is out of order", t.Format(time.RFC3339))
+	}
+
+	start := a.size.Start(t, a.loc)
+	if _, ok := a.buckets[start]; !ok {
+		if end := a.size.End(start); a.next.IsZero() || end.Before(a.next) {
+			a.next = end
+		}
+	}
+	a.buckets[start] = append(a.buckets[start], value)
+
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	if wall := time.Date(year, month, day, hour, minute, second, t.Nanosecond(), time.UTC); wall.After(a.cursor) {
+		a.cursor = wall
+		if closed := a.closed(); !a.next.After(closed) {
+			return a.emitBefore(closed)
+		}
+	}
+	return nil
+}
+
+// Flush emits the open buckets.
+func (a *Aggregator) Flush() error {
+	return a.emitBefore(time.Time{})
+}
+
+// closed returns the instant no later sample can be before. Every bucket
+// ending by then is closed.
+func (a *Aggregator) closed() time.Time {
+	if a.cursor.IsZero() {
+		return time.Time{}
+	}
+	return a.cursor.Add(-maxUTCOffset)
+}
+
+// emitBefore emits the open buckets ending by t, from the earliest to the
+// latest, or all of them if t is zero.
+func (a *Aggregator) emitBefore(t time.Time) error {
+	var starts []time.Time
+	a.next = time.Time{}
+	for start := range a.buckets {
+		if end := a.size.End(start); t.IsZero() || !end.After(t) {
+			starts = append(starts, start)
+		} else if a.next.IsZero() || end.Before(a.next) {
+			a.next = end
+		}
+	}
+	slices.SortFunc(starts, time.Time.Compare)
+	for _, start := range starts {
+		bucket := a.bucket(start, a.buckets[start])
+		delete(a.buckets, start)
+		if err := a.emit(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Aggregator) bucket(start time.Time, values []float64) *health.WearableDataBucket {
+	bucket := &health.WearableDataBucket{
+		Start: start.Format(time.RFC3339),
+		End:   a.size.End(start).Format(time.RFC3339),
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	for _, function := range a.functions {
+		switch function {
+		case "count":
+			count := int64(len(sorted))
+			bucket.Count = &count
+		case "min":
+			bucket.Min = &sorted[0]
+		case "max":
+			bucket.Max = &sorted[len(sorted)-1]
+		case "avg":
+			var sum float64
+			for _, value := range sorted {
+				sum += value
+			}
+			avg := sum / float64(len(sorted))
+			bucket.Avg = &avg
+		default:
+			p, _ := function.percentile()
+			if bucket.Percentiles == nil {
+				bucket.Percentiles = make(map[string]float64)
+			}
+			bucket.Percentiles[string(function)] = percentile(sorted, p)
+		}
+	}
+
+	return bucket
+}
@@ -0,0 +1,228 @@
+package timeseries
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// sample is a value taken at an RFC 3339 time.
+type sample struct {
+	at    string
+	value float64
+}
+
+// aggregate rolls samples up and describes every emitted bucket as
+// "start count min max avg".
+func aggregate(t *testing.T, size BucketSize, loc *time.Location, samples []sample) []string {
+	t.Helper()
+
+	var buckets []string
+	emit := func(b *health.WearableDataBucket) error {
+		buckets = append(buckets, fmt.Sprintf("%s %d %g %g %g", b.Start, b.GetCount(), b.GetMin(), b.GetMax(), b.GetAvg()))
+		return nil
+	}
+	aggregator := NewAggregator(size, loc, DefaultFunctions, emit)
+	for _, s := range samples {
+		at, err := time.Parse(time.RFC3339, s.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := aggregator.Add(at, s.value); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+	if err := aggregator.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	return buckets
+}
+
+func TestAggregator(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		size    BucketSize
+		loc     *time.Location
+		samples []sample
+		want    []string
+	}{
+		{
+			name: "hours",
+			size: Hour,
+			loc:  time.UTC,
+			samples: []sample{
+				{"2026-10-01T10:00:00Z", 60}, {"2026-10-01T10:59:59Z", 80},
+				{"2026-10-01T11:00:00Z", 70}, {"2026-10-01T13:30:00Z", 90},
+			},
+			want: []string{
+				"2026-10-01T10:00:00Z 2 60 80 70",
+				"2026-10-01T11:00:00Z 1 70 70 70",
+				"2026-10-01T13:00:00Z 1 90 90 90",
+			},
+		},
+		{
+			name: "out of order samples",
+			size: Minute,
+			loc:  time.UTC,
+			samples: []sample{
+				{"2026-10-01T10:01:30Z", 3}, {"2026-10-01T10:00:10Z", 1},
+				{"2026-10-01T10:01:10Z", 5}, {"2026-10-01T10:00:50Z", 2},
+			},
+			want: []string{
+				"2026-10-01T10:00:00Z 2 1 2 1.5",
+				"2026-10-01T10:01:00Z 2 3 5 4",
+			},
+		},
+		{
+			name: "offsets out of lexical order",
+			size: Hour,
+			loc:  time.UTC,
+			samples: []sample{
+				{"2026-10-01T09:30:00Z", 1}, {"2026-10-01T10:15:00+02:00", 2}, {"2026-10-01T09:45:00Z", 3},
+			},
+			want: []string{
+				"2026-10-01T08:00:00Z 1 2 2 2",
+				"2026-10-01T09:00:00Z 2 1 3 2",
+			},
+		},
+		{
+			name: "days in a time zone",
+			size: Day,
+			loc:  berlin,
+			samples: []sample{
+				{"2026-10-01T21:59:00Z", 10}, {"2026-10-01T22:00:00Z", 20},
+			},
+			want: []string{
+				"2026-10-01T00:00:00+02:00 1 10 10 10",
+				"2026-10-02T00:00:00+02:00 1 20 20 20",
+			},
+		},
+		{
+			name:    "no samples",
+			size:    Hour,
+			loc:     time.UTC,
+			samples: nil,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := aggregate(t, tt.size, tt.loc, tt.samples)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("buckets =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestAggregatorFlushesOnce(t *testing.T) {
+	var emitted int
+	aggregator := NewAggregator(Hour, time.UTC, DefaultFunctions, func(*health.WearableDataBucket) error {
+		emitted++
+		return nil
+	})
+	if err := aggregator.Add(time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := aggregator.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := aggregator.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if emitted != 1 {
+		t.Errorf("emitted %d buckets, want 1", emitted)
+	}
+}
+
+func TestAggregatorEmitsClosedBuckets(t *testing.T) {
+	var emitted []string
+	aggregator := NewAggregator(Hour, time.UTC, DefaultFunctions, func(b *health.WearableDataBucket) error {
+		emitted = append(emitted, b.Start)
+		return nil
+	})
+
+	steps := []struct {
+		at      string
+		want    string
+		wantErr bool
+	}{
+		{at: "2026-10-01T10:00:00Z", want: ""},
+		{at: "2026-10-01T11:00:00-02:00", want: ""},
+		// Later samples may carry offsets up to +14:00, so 10:00 stays open
+		{at: "2026-10-02T00:00:00Z", want: ""},
+		{at: "2026-10-02T00:00:00+01:00", want: ""},
+		{at: "2026-10-02T01:00:00Z", want: "2026-10-01T10:00:00Z"},
+		{at: "2026-10-02T13:30:00Z", want: "2026-10-01T10:00:00Z 2026-10-01T13:00:00Z"},
+		{at: "2026-10-01T23:00:00Z", wantErr: true},
+	}
+	for _, step := range steps {
+		at, err := time.Parse(time.RFC3339, step.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = aggregator.Add(at, 1)
+		if (err != nil) != step.wantErr {
+			t.Fatalf("Add(%s) error = %v, want error %t", step.at, err, step.wantErr)
+		}
+		if step.wantErr {
+			continue
+		}
+		if got := strings.Join(emitted, " "); got != step.want {
+			t.Errorf("after Add(%s) emitted %q, want %q", step.at, got, step.want)
+		}
+	}
+
+	if err := aggregator.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(emitted[2:], " "); got != "2026-10-01T23:00:00Z 2026-10-02T00:00:00Z 2026-10-02T01:00:00Z 2026-10-02T13:00:00Z" {
+		t.Errorf("Flush() emitted %q, want the open buckets in order", got)
+	}
+}
+
+func TestBucketSizeEnd(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		size  BucketSize
+		start time.Time
+		want  time.Duration
+	}{
+		{name: "minute", size: Minute, start: time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC), want: time.Minute},
+		{name: "hour", size: Hour, start: time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC), want: time.Hour},
+		{name: "day", size: Day, start: time.Date(2026, 10, 1, 0, 0, 0, 0, berlin), want: 24 * time.Hour},
+		{name: "day ending daylight saving", size: Day, start: time.Date(2026, 10, 25, 0, 0, 0, 0, berlin), want: 25 * time.Hour},
+		{name: "day starting daylight saving", size: Day, start: time.Date(2026, 3, 29, 0, 0, 0, 0, berlin), want: 23 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := tt.size.End(tt.start).Sub(tt.start); got != tt.want {
+			t.Errorf("%s: End() - start = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseBucketSize(t *testing.T) {
+	for _, name := range []string{"minute", "hour", "day"} {
+		if size, err := ParseBucketSize(name); err != nil || string(size) != name {
+			t.Errorf("ParseBucketSize(%q) = %q, %v", name, size, err)
+		}
+	}
+	if _, err := ParseBucketSize("week"); err == nil {
+		t.Error(`ParseBucketSize("week") succeeded, want an error`)
+	}
+}
@@ -0,0 +1,67 @@
+package timeseries
+
+import (
+	"fmt"
+	"time"
+
+	// Bucketing needs the IANA time zones, which the runtime image lacks
+	_ "time/tzdata"
+)
+
+// BucketSize is the width of the buckets samples are rolled up into.
+type BucketSize string
+
+// Supported bucket sizes.
+const (
+	Minute BucketSize = "minute"
+	Hour   BucketSize = "hour"
+	Day    BucketSize = "day"
+)
+
+// ParseBucketSize parses a bucket size name.
+func ParseBucketSize(name string) (BucketSize, error) {
+	switch size := BucketSize(name); size {
+	case Minute, Hour, Day:
+		return size, nil
+	}
+	return "", fmt.Errorf("unknown bucket size %q, expected minute, hour or day", name)
+}
+
+// Start returns the start of the bucket holding t, aligned to the wall clock
+// of loc.
+func (b BucketSize) Start(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	switch b {
+	case Minute:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	case Hour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+}
+
+// End returns the end of the bucket starting at start. Days follow the
+// calendar, so they last 23 or 25 hours across daylight saving changes.
+func (b BucketSize) End(start time.Time) time.Time {
+	switch b {
+	case Minute:
+		return start.Add(time.Minute)
+	case Hour:
+		return start.Add(time.Hour)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Count estimates the number of buckets between from and to.
+func (b BucketSize) Count(from, to time.Time) int64 {
+	width := time.Minute
+	switch b {
+	case Hour:
+		width = time.Hour
+	case Day:
+		width = 24 * time.Hour
+	}
+	return int64(to.Sub(from)/width) + 1
+}
@@ -0,0 +1,58 @@
+package timeseries
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Function is an aggregation applied to the samples of a bucket: "count",
+// "min", "max", "avg", or a percentile such as "p95" or "p99.9".
+type Function string
+
+// DefaultFunctions are applied when none are requested.
+var DefaultFunctions = []Function{"count", "min", "max", "avg"}
+
+// ParseFunctions parses a comma separated list of functions.
+func ParseFunctions(list string) ([]Function, error) {
+	var functions []Function
+	for _, name := range strings.Split(list, ",") {
+		function := Function(strings.TrimSpace(name))
+		if _, err := function.percentile(); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(functions, function) {
+			functions = append(functions, function)
+		}
+	}
+	return functions, nil
+}
+
+// percentile returns the percentile computed by f, or -1 for the other
+// functions.
+func (f Function) percentile() (float64, error) {
+	switch f {
+	case "count", "min", "max", "avg":
+		return -1, nil
+	}
+
+	rank, ok := strings.CutPrefix(string(f), "p")
+	if ok {
+		p, err := strconv.ParseFloat(rank, 64)
+		if err == nil && p >= 0 && p <= 100 {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown function %q, expected count, min, max, avg or pNN", f)
+}
+
+// percentile returns the p-th percentile of sorted values, interpolating
+// linearly between the closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
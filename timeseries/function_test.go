@@ -0,0 +1,56 @@
+package timeseries
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseFunctions(t *testing.T) {
+	tests := []struct {
+		list    string
+		want    []Function
+		wantErr bool
+	}{
+		{list: "count,min,max,avg", want: []Function{"count", "min", "max", "avg"}},
+		{list: " p95 , p99.9,p0,p100", want: []Function{"p95", "p99.9", "p0", "p100"}},
+		{list: "avg,avg", want: []Function{"avg"}},
+		{list: "median", wantErr: true},
+		{list: "p101", wantErr: true},
+		{list: "p-1", wantErr: true},
+		{list: "pxx", wantErr: true},
+		{list: "avg,", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFunctions(tt.list)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseFunctions(%q) error = %v, want error %t", tt.list, err, tt.wantErr)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ParseFunctions(%q) = %v, want %v", tt.list, got, tt.want)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []float64
+		p      float64
+		want   float64
+	}{
+		{name: "single value", sorted: []float64{7}, p: 95, want: 7},
+		{name: "minimum", sorted: []float64{1, 2, 3, 4}, p: 0, want: 1},
+		{name: "maximum", sorted: []float64{1, 2, 3, 4}, p: 100, want: 4},
+		{name: "median of odd count", sorted: []float64{1, 2, 3}, p: 50, want: 2},
+		{name: "median of even count", sorted: []float64{1, 2, 3, 4}, p: 50, want: 2.5},
+		{name: "interpolated", sorted: []float64{10, 20, 30, 40, 50}, p: 90, want: 46},
+	}
+
+	for _, tt := range tests {
+		if got := percentile(tt.sorted, tt.p); got != tt.want {
+			t.Errorf("%s: percentile(%v, %g) = %g, want %g", tt.name, tt.sorted, tt.p, got, tt.want)
+		}
+	}
+}
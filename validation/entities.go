@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// Vocabularies of the enumerated entity fields.
+var (
+	RecordTypes = []string{
+		"diagnosis", "lab_result", "prescription", "imaging", "procedure",
+		"vaccination", "allergy", "visit_note", "discharge_summary",
+	}
+	GeneticDataTypes = []string{
+		"snp", "genotype", "variant", "sequence", "ancestry", "pharmacogenomic",
+	}
+	LifestyleDataTypes = []string{
+		"diet", "exercise", "sleep", "alcohol", "smoking", "stress", "hydration",
+	}
+	DeviceTypes = []string{
+		"smartwatch", "fitness_tracker", "smart_ring", "heart_rate_monitor",
+		"blood_pressure_monitor", "glucose_monitor", "pulse_oximeter", "smart_scale",
+	}
+	WearableDataTypes = []string{
+		"heart_rate", "steps", "sleep", "blood_pressure", "blood_glucose",
+		"oxygen_saturation", "calories", "temperature", "weight",
+	}
+	RecommendationTypes = []string{
+		"diet", "exercise", "sleep", "medication", "checkup", "lifestyle", "mental_health",
+	}
+)
+
+// Bounds of HealthRecommendation.priority, 1 being the most urgent.
+const (
+	MinPriority = 1
+	MaxPriority = 5
+)
+
+// MedicalRecord validates a medical record. When fields are given, as for a
+// partial update, only those fields are checked.
+func MedicalRecord(m *health.MedicalRecord, fields ...string) Errors {
+	c := newChecker(fields)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.required("record_type", m.RecordType)
+	c.oneOf("record_type", m.RecordType, RecordTypes)
+	c.required("record_date", m.RecordDate)
+	c.date("record_date", m.RecordDate)
+	c.maxLength("description", m.Description, maxDescriptionLength)
+	c.maxLength("doctor_id", m.DoctorId, maxIDLength)
+	c.urls("attachments", m.Attachments)
+	c.timestamp("created_at", m.CreatedAt)
+	c.timestamp("updated_at", m.UpdatedAt)
+	return c.errs
+}
+
+// GeneticData validates a genetic data record. When fields are given, only
+// those fields are checked.
+func GeneticData(m *health.GeneticData, fields ...string) Errors {
+	c := newChecker(fields)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.required("data_type", m.DataType)
+	c.oneOf("data_type", m.DataType, GeneticDataTypes)
+	c.message("data_value", m.DataValue, true)
+	c.required("analysis_date", m.AnalysisDate)
+	c.date("analysis_date", m.AnalysisDate)
+	c.timestamp("created_at", m.CreatedAt)
+	c.timestamp("updated_at", m.UpdatedAt)
+	return c.errs
+}
+
+// LifestyleData validates a lifestyle data record. When fields are given, only
+// those fields are checked.
+func LifestyleData(m *health.LifestyleData, fields ...string) Errors {
+	c := newChecker(fields)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.required("data_type", m.DataType)
+	c.oneOf("data_type", m.DataType, LifestyleDataTypes)
+	c.message("data_value", m.DataValue, true)
+	c.vital("data_value", m.DataValue)
+	c.required("recorded_date", m.RecordedDate)
+	c.date("recorded_date", m.RecordedDate)
+	c.timestamp("created_at", m.CreatedAt)
+	c.timestamp("updated_at", m.UpdatedAt)
+	return c.errs
+}
+
+// WearableData validates a wearable data sample. When fields are given, only
+// those fields are checked.
+func WearableData(m *health.WearableData, fields ...string) Errors {
+	c := newChecker(fields)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.required("device_type", m.DeviceType)
+	c.oneOf("device_type", m.DeviceType, DeviceTypes)
+	c.required("data_type", m.DataType)
+	c.oneOf("data_type", m.DataType, WearableDataTypes)
+	c.message("data_value", m.DataValue, true)
+	c.vital("data_value", m.DataValue)
+	c.required("recorded_timestamp", m.RecordedTimestamp)
+	c.timestamp("recorded_timestamp", m.RecordedTimestamp)
+	c.timestamp("created_at", m.CreatedAt)
+	c.timestamp("updated_at", m.UpdatedAt)
+	return c.errs
+}
+
+// HealthRecommendation validates a health recommendation. When fields are
+// given, only those fields are checked.
+func HealthRecommendation(m *health.HealthRecommendation, fields ...string) Errors {
+	c := newChecker(fields)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.required("recommendation_type", m.RecommendationType)
+	c.oneOf("recommendation_type", m.RecommendationType, RecommendationTypes)
+	c.required("description", m.Description)
+	c.maxLength("description", m.Description, maxDescriptionLength)
+	c.between("priority", int64(m.Priority), MinPriority, MaxPriority)
+	c.timestamp("created_at", m.CreatedAt)
+	c.timestamp("updated_at", m.UpdatedAt)
+	return c.errs
+}
@@ -0,0 +1,196 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestMedicalRecord(t *testing.T) {
+	valid := func() *health.MedicalRecord {
+		return &health.MedicalRecord{
+			UserId: "u1", RecordType: "diagnosis", RecordDate: "2026-10-01",
+			Description: "Seasonal allergies", DoctorId: "d1",
+			Attachments: []string{"https://files.example.com/scan.pdf"},
+			CreatedAt:   "2026-10-01T08:00:00Z",
+		}
+	}
+	attachments := func(n int) []string {
+		urls := make([]string, n)
+		for i := range urls {
+			urls[i] = "https://files.example.com/scan.pdf"
+		}
+		return urls
+	}
+
+	tests := []struct {
+		name   string
+		edit   func(*health.MedicalRecord)
+		fields []string
+		want   string
+	}{
+		{name: "valid", edit: func(*health.MedicalRecord) {}},
+		{name: "empty", edit: func(m *health.MedicalRecord) { *m = health.MedicalRecord{} }, want: "user_id,record_type,record_date"},
+		{name: "blank user", edit: func(m *health.MedicalRecord) { m.UserId = "  " }, want: "user_id"},
+		{name: "long user", edit: func(m *health.MedicalRecord) { m.UserId = strings.Repeat("u", maxIDLength+1) }, want: "user_id"},
+		{name: "longest user", edit: func(m *health.MedicalRecord) { m.UserId = strings.Repeat("é", maxIDLength) }},
+		{name: "long doctor", edit: func(m *health.MedicalRecord) { m.DoctorId = strings.Repeat("d", maxIDLength+1) }, want: "doctor_id"},
+		{name: "unknown record type", edit: func(m *health.MedicalRecord) { m.RecordType = "Diagnosis" }, want: "record_type"},
+		{name: "date with time", edit: func(m *health.MedicalRecord) { m.RecordDate = "2026-10-01T08:00:00Z" }, want: "record_date"},
+		{name: "impossible date", edit: func(m *health.MedicalRecord) { m.RecordDate = "2026-02-30" }, want: "record_date"},
+		{name: "long description", edit: func(m *health.MedicalRecord) { m.Description = strings.Repeat("x", maxDescriptionLength+1) }, want: "description"},
+		{name: "timestamp without zone", edit: func(m *health.MedicalRecord) { m.UpdatedAt = "2026-10-01T08:00:00" }, want: "updated_at"},
+		{name: "fractional timestamp", edit: func(m *health.MedicalRecord) { m.UpdatedAt = "2026-10-01T08:00:00.123+02:00" }},
+		{name: "most attachments", edit: func(m *health.MedicalRecord) { m.Attachments = attachments(maxAttachments) }},
+		{name: "too many attachments", edit: func(m *health.MedicalRecord) { m.Attachments = attachments(maxAttachments + 1) }, want: "attachments"},
+		{name: "relative attachment", edit: func(m *health.MedicalRecord) { m.Attachments = []string{"https://example.com/a", "/scan.pdf"} }, want: "attachments[1]"},
+		{name: "file attachment", edit: func(m *health.MedicalRecord) { m.Attachments = []string{"file:///etc/passwd"} }, want: "attachments[0]"},
+		{name: "attachment without host", edit: func(m *health.MedicalRecord) { m.Attachments = []string{"https:///scan.pdf"} }, want: "attachments[0]"},
+		{name: "long attachment", edit: func(m *health.MedicalRecord) {
+			m.Attachments = []string{"https://example.com/" + strings.Repeat("a", maxURLLength)}
+		}, want: "attachments[0]"},
+		{name: "patch checks only its fields", edit: func(m *health.MedicalRecord) {
+			*m = health.MedicalRecord{Description: "Updated", RecordDate: "yesterday"}
+		}, fields: []string{"description"}},
+		{name: "patch checks the fields it sets", edit: func(m *health.MedicalRecord) {
+			*m = health.MedicalRecord{RecordType: "unknown"}
+		}, fields: []string{"record_type", "description"}, want: "record_type"},
+		{name: "patch cannot clear a required field", edit: func(m *health.MedicalRecord) { m.RecordDate = "" }, fields: []string{"record_date"}, want: "record_date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := valid()
+			tt.edit(record)
+			got := MedicalRecord(record, tt.fields...)
+			if fields(got) != tt.want {
+				t.Errorf("MedicalRecord() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeneticData(t *testing.T) {
+	value, err := anypb.New(structpb.NewStringValue("rs53576:AG"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := func() *health.GeneticData {
+		return &health.GeneticData{UserId: "u1", DataType: "snp", DataValue: value, AnalysisDate: "2026-10-01"}
+	}
+
+	tests := []struct {
+		name   string
+		edit   func(*health.GeneticData)
+		fields []string
+		want   string
+	}{
+		{name: "valid", edit: func(*health.GeneticData) {}},
+		{name: "empty", edit: func(m *health.GeneticData) { *m = health.GeneticData{} }, want: "user_id,data_type,data_value,analysis_date"},
+		{name: "unknown data type", edit: func(m *health.GeneticData) { m.DataType = "steps" }, want: "data_type"},
+		{name: "empty data value", edit: func(m *health.GeneticData) { m.DataValue = &anypb.Any{} }},
+		{name: "large data value", edit: func(m *health.GeneticData) {
+			m.DataValue = &anypb.Any{TypeUrl: value.TypeUrl, Value: make([]byte, maxDataValueBytes)}
+		}, want: "data_value"},
+		{name: "analysis date format", edit: func(m *health.GeneticData) { m.AnalysisDate = "01/10/2026" }, want: "analysis_date"},
+		{name: "patch without data value", edit: func(m *health.GeneticData) { m.DataValue = nil }, fields: []string{"data_type"}},
+		{name: "patch clearing data value", edit: func(m *health.GeneticData) { m.DataValue = nil }, fields: []string{"data_value"}, want: "data_value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid()
+			tt.edit(data)
+			got := GeneticData(data, tt.fields...)
+			if fields(got) != tt.want {
+				t.Errorf("GeneticData() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWearableData(t *testing.T) {
+	value, err := anypb.New(structpb.NewNumberValue(8500))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := func() *health.WearableData {
+		return &health.WearableData{
+			UserId: "u1", DeviceType: "smartwatch", DataType: "steps", DataValue: value,
+			RecordedTimestamp: "2026-10-01T08:00:00Z",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		edit   func(*health.WearableData)
+		fields []string
+		want   string
+	}{
+		{name: "valid", edit: func(*health.WearableData) {}},
+		{name: "empty", edit: func(m *health.WearableData) { *m = health.WearableData{} }, want: "user_id,device_type,data_type,data_value,recorded_timestamp"},
+		{name: "unknown device", edit: func(m *health.WearableData) { m.DeviceType = "phone" }, want: "device_type"},
+		{name: "lifestyle data type", edit: func(m *health.WearableData) { m.DataType = "diet" }, want: "data_type"},
+		{name: "date for timestamp", edit: func(m *health.WearableData) { m.RecordedTimestamp = "2026-10-01" }, want: "recorded_timestamp"},
+		{name: "patch device", edit: func(m *health.WearableData) { *m = health.WearableData{DeviceType: "smart_ring"} }, fields: []string{"device_type"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid()
+			tt.edit(data)
+			got := WearableData(data, tt.fields...)
+			if fields(got) != tt.want {
+				t.Errorf("WearableData() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthRecommendation(t *testing.T) {
+	valid := func() *health.HealthRecommendation {
+		return &health.HealthRecommendation{
+			UserId: "u1", RecommendationType: "sleep", Description: "Go to bed earlier", Priority: 2,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		edit   func(*health.HealthRecommendation)
+		fields []string
+		want   string
+	}{
+		{name: "valid", edit: func(*health.HealthRecommendation) {}},
+		{name: "empty", edit: func(m *health.HealthRecommendation) { *m = health.HealthRecommendation{} }, want: "user_id,recommendation_type,description,priority"},
+		{name: "unknown type", edit: func(m *health.HealthRecommendation) { m.RecommendationType = "surgery" }, want: "recommendation_type"},
+		{name: "long description", edit: func(m *health.HealthRecommendation) { m.Description = strings.Repeat("x", maxDescriptionLength+1) }, want: "description"},
+		{name: "most urgent", edit: func(m *health.HealthRecommendation) { m.Priority = MinPriority }},
+		{name: "least urgent", edit: func(m *health.HealthRecommendation) { m.Priority = MaxPriority }},
+		{name: "priority above range", edit: func(m *health.HealthRecommendation) { m.Priority = MaxPriority + 1 }, want: "priority"},
+		{name: "created at format", edit: func(m *health.HealthRecommendation) { m.CreatedAt = "now" }, want: "created_at"},
+		{name: "patch without priority", edit: func(m *health.HealthRecommendation) { m.Priority = 0 }, fields: []string{"description"}},
+		{name: "patch priority", edit: func(m *health.HealthRecommendation) { m.Priority = 0 }, fields: []string{"priority"}, want: "priority"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := valid()
+			tt.edit(rec)
+			got := HealthRecommendation(rec, tt.fields...)
+			if fields(got) != tt.want {
+				t.Errorf("HealthRecommendation() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorsError(t *testing.T) {
+	errs := Errors{{Field: "user_id", Message: "is required"}, {Field: "priority", Message: "must be between 1 and 5"}}
+	if got, want := errs.Error(), "user_id: is required; priority: must be between 1 and 5"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"slices"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// Vocabularies of the health goal fields.
+var (
+	GoalSources    = []string{"wearable", "lifestyle"}
+	GoalPeriods    = []string{"daily", "weekly"}
+	GoalDirections = []string{"at_least", "at_most"}
+
+	// Progress sums the values of a day, so goals only track the data types
+	// whose values add up, not levels such as heart_rate or weight.
+	GoalWearableDataTypes  = []string{"steps", "calories", "sleep"}
+	GoalLifestyleDataTypes = []string{"exercise", "sleep", "hydration", "alcohol", "smoking"}
+	// GoalDataTypes are the goal data types of either source.
+	GoalDataTypes = union(GoalWearableDataTypes, GoalLifestyleDataTypes)
+)
+
+// HealthGoal validates a health goal. When fields are given, only those fields
+// are checked; data_type is then matched against the source only when the
+// source is among them.
+func HealthGoal(m *health.HealthGoal, fields ...string) Errors {
+	c := newChecker(fields)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.required("source", m.Source)
+	c.oneOf("source", m.Source, GoalSources)
+	c.required("data_type", m.DataType)
+	switch m.Source {
+	case "wearable":
+		c.oneOf("data_type", m.DataType, GoalWearableDataTypes)
+	case "lifestyle":
+		c.oneOf("data_type", m.DataType, GoalLifestyleDataTypes)
+	default:
+		c.oneOf("data_type", m.DataType, GoalDataTypes)
+	}
+	c.positive("target", m.Target)
+	c.required("period", m.Period)
+	c.oneOf("period", m.Period, GoalPeriods)
+	c.oneOf("direction", m.Direction, GoalDirections)
+	c.required("start_date", m.StartDate)
+	c.date("start_date", m.StartDate)
+	c.date("end_date", m.EndDate)
+	if c.checked("end_date") && m.EndDate != "" && m.StartDate != "" && m.EndDate < m.StartDate {
+		c.fail("end_date", "must not be before start_date")
+	}
+	c.maxLength("description", m.Description, maxDescriptionLength)
+	c.timestamp("created_at", m.CreatedAt)
+	c.timestamp("updated_at", m.UpdatedAt)
+	return c.errs
+}
+
+// union returns the distinct values of lists, in order.
+func union(lists ...[]string) []string {
+	var values []string
+	for _, list := range lists {
+		for _, value := range list {
+			if !slices.Contains(values, value) {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// fields joins the fields of errs, in order.
+func fields(errs Errors) string {
+	names := make([]string, len(errs))
+	for i, fe := range errs {
+		names[i] = fe.Field
+	}
+	return strings.Join(names, ",")
+}
+
+func TestHealthGoal(t *testing.T) {
+	valid := func() *health.HealthGoal {
+		return &health.HealthGoal{
+			UserId: "u1", Source: "wearable", DataType: "steps", Target: 10000,
+			Period: "daily", StartDate: "2026-10-01",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		edit   func(*health.HealthGoal)
+		fields []string
+		want   string
+	}{
+		{name: "valid", edit: func(*health.HealthGoal) {}},
+		{name: "lifestyle sleep", edit: func(m *health.HealthGoal) { m.Source, m.DataType = "lifestyle", "sleep" }},
+		{name: "at most drinks", edit: func(m *health.HealthGoal) { m.Source, m.DataType, m.Direction = "lifestyle", "alcohol", "at_most" }},
+		{name: "heart rate does not add up", edit: func(m *health.HealthGoal) { m.DataType = "heart_rate" }, want: "data_type"},
+		{name: "weight does not add up", edit: func(m *health.HealthGoal) { m.DataType = "weight" }, want: "data_type"},
+		{name: "stress does not add up", edit: func(m *health.HealthGoal) { m.Source, m.DataType = "lifestyle", "stress" }, want: "data_type"},
+		{name: "data type of the other source", edit: func(m *health.HealthGoal) { m.DataType = "exercise" }, want: "data_type"},
+		{name: "unknown source", edit: func(m *health.HealthGoal) { m.Source, m.DataType = "manual", "temperature" }, want: "source,data_type"},
+		{name: "data type without the source", edit: func(m *health.HealthGoal) { m.Source, m.DataType = "", "blood_pressure" }, fields: []string{"data_type"}, want: "data_type"},
+		{name: "target", edit: func(m *health.HealthGoal) { m.Target = 0 }, want: "target"},
+		{name: "end before start", edit: func(m *health.HealthGoal) { m.EndDate = "2026-09-30" }, want: "end_date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := valid()
+			tt.edit(goal)
+			got := HealthGoal(goal, tt.fields...)
+			if fields(got) != tt.want {
+				t.Errorf("HealthGoal() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}
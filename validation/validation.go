@@ -0,0 +1,151 @@
+package validation
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Size limits of entity fields.
+const (
+	maxIDLength          = 128
+	maxDescriptionLength = 4000
+	maxAttachments       = 20
+	maxURLLength         = 2048
+	maxDataValueBytes    = 64 << 10
+)
+
+// dateLayout is the format of the date fields of the health entities.
+const dateLayout = "2006-01-02"
+
+// FieldError describes why a single field is invalid.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Errors lists every invalid field of a payload.
+type Errors []FieldError
+
+// Error implements error.
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Field + ": " + fe.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// checker accumulates field errors. When fields is set, as for partial
+// updates, only those fields are checked.
+type checker struct {
+	fields []string
+	errs   Errors
+}
+
+func newChecker(fields []string) *checker {
+	return &checker{fields: fields}
+}
+
+func (c *checker) checked(field string) bool {
+	return len(c.fields) == 0 || slices.Contains(c.fields, field)
+}
+
+func (c *checker) fail(field, message string) {
+	for _, fe := range c.errs {
+		if fe.Field == field {
+			return
+		}
+	}
+	c.errs = append(c.errs, FieldError{Field: field, Message: message})
+}
+
+func (c *checker) required(field, value string) {
+	if c.checked(field) && strings.TrimSpace(value) == "" {
+		c.fail(field, "is required")
+	}
+}
+
+func (c *checker) maxLength(field, value string, n int) {
+	if c.checked(field) && utf8.RuneCountInString(value) > n {
+		c.fail(field, "must be at most "+strconv.Itoa(n)+" characters")
+	}
+}
+
+func (c *checker) date(field, value string) {
+	if !c.checked(field) || value == "" {
+		return
+	}
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		c.fail(field, "must be a date in YYYY-MM-DD format")
+	}
+}
+
+func (c *checker) timestamp(field, value string) {
+	if !c.checked(field) || value == "" {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+		c.fail(field, "must be an RFC 3339 timestamp")
+	}
+}
+
+func (c *checker) oneOf(field, value string, allowed []string) {
+	if !c.checked(field) || value == "" {
+		return
+	}
+	if !slices.Contains(allowed, value) {
+		c.fail(field, "must be one of "+strings.Join(allowed, ", "))
+	}
+}
+
+func (c *checker) between(field string, value, min, max int64) {
+	if c.checked(field) && (value < min || value > max) {
+		c.fail(field, "must be between "+strconv.FormatInt(min, 10)+" and "+strconv.FormatInt(max, 10))
+	}
+}
+
+func (c *checker) urls(field string, values []string) {
+	if !c.checked(field) {
+		return
+	}
+	if len(values) > maxAttachments {
+		c.fail(field, "must have at most "+strconv.Itoa(maxAttachments)+" entries")
+		return
+	}
+	for i, value := range values {
+		entry := field + "[" + strconv.Itoa(i) + "]"
+		u, err := url.Parse(value)
+		switch {
+		case len(value) > maxURLLength:
+			c.fail(entry, "must be at most "+strconv.Itoa(maxURLLength)+" characters")
+		case err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "":
+			c.fail(entry, "must be an absolute http or https URL")
+		}
+	}
+}
+
+func (c *checker) message(field string, value proto.Message, required bool) {
+	if !c.checked(field) {
+		return
+	}
+	switch {
+	case value == nil || !value.ProtoReflect().IsValid():
+		if required {
+			c.fail(field, "is required")
+		}
+	case proto.Size(value) > maxDataValueBytes:
+		c.fail(field, "must be at most "+strconv.Itoa(maxDataValueBytes)+" bytes")
+	}
+}
+
+func (c *checker) positive(field string, value float64) {
+	if c.checked(field) && !(value > 0) {
+		c.fail(field, "must be greater than 0")
+	}
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+// SleepQualities are the subjective sleep quality ratings.
+var SleepQualities = []string{"Good", "Average", "Poor"}
+
+// Plausible ranges of vital readings.
+const (
+	MaxSleepDurationMs = int64(24 * time.Hour / time.Millisecond)
+	MinHeartRate       = 20
+	MaxHeartRate       = 300
+)
+
+// SleepData validates a night of sleep.
+func SleepData(m *health.SleepData) Errors {
+	c := newChecker(nil)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.sleep("", m)
+	c.required("recorded_date", m.RecordedDate)
+	c.date("recorded_date", m.RecordedDate)
+	return c.errs
+}
+
+// HeartRateData validates a heart rate reading.
+func HeartRateData(m *health.HeartRateData) Errors {
+	c := newChecker(nil)
+	c.required("user_id", m.UserId)
+	c.maxLength("user_id", m.UserId, maxIDLength)
+	c.heartRate("", m)
+	c.required("recorded_timestamp", m.RecordedTimestamp)
+	c.timestamp("recorded_timestamp", m.RecordedTimestamp)
+	return c.errs
+}
+
+// sleep checks the reading of a night of sleep, with its fields named after
+// prefix.
+func (c *checker) sleep(prefix string, m *health.SleepData) {
+	c.between(prefix+"sleep_duration", m.SleepDuration, 1, MaxSleepDurationMs)
+	c.required(prefix+"sleep_quality", m.SleepQuality)
+	c.oneOf(prefix+"sleep_quality", m.SleepQuality, SleepQualities)
+}
+
+// heartRate checks a heart rate reading, with its fields named after prefix.
+func (c *checker) heartRate(prefix string, m *health.HeartRateData) {
+	c.between(prefix+"heart_rate", int64(m.HeartRate), MinHeartRate, MaxHeartRate)
+}
+
+// vital checks the reading of a data_value holding a typed SleepData or
+// HeartRateData. The user and recording time are those of the enclosing
+// record, so they are not checked.
+func (c *checker) vital(field string, value *anypb.Any) {
+	if !c.checked(field) || value == nil {
+		return
+	}
+
+	// The reading is checked whole, even when only data_value is
+	// patched, since a patch replaces the whole value.
+	v := newChecker(nil)
+	sleep, heartRate := &health.SleepData{}, &health.HeartRateData{}
+	switch {
+	case value.MessageIs(sleep):
+		if err := value.UnmarshalTo(sleep); err != nil {
+			c.fail(field, "must be a valid sleep reading")
+			return
+		}
+		v.sleep(field+".", sleep)
+	case value.MessageIs(heartRate):
+		if err := value.UnmarshalTo(heartRate); err != nil {
+			c.fail(field, "must be a valid heart rate reading")
+			return
+		}
+		v.heartRate(field+".", heartRate)
+	}
+	c.errs = append(c.errs, v.errs...)
+}
@@ -0,0 +1,117 @@
+package validation
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/health-analytics-service/api-gateway-health-analytics/genproto/health"
+)
+
+func TestSleepData(t *testing.T) {
+	valid := func() *health.SleepData {
+		return &health.SleepData{UserId: "u1", SleepDuration: 8 * 3600 * 1000, SleepQuality: "Good", RecordedDate: "2026-10-01"}
+	}
+
+	tests := []struct {
+		name string
+		edit func(*health.SleepData)
+		want string
+	}{
+		{name: "valid", edit: func(*health.SleepData) {}},
+		{name: "empty", edit: func(m *health.SleepData) { *m = health.SleepData{} }, want: "user_id,sleep_duration,sleep_quality,recorded_date"},
+		{name: "whole day", edit: func(m *health.SleepData) { m.SleepDuration = MaxSleepDurationMs }},
+		{name: "over a day", edit: func(m *health.SleepData) { m.SleepDuration = MaxSleepDurationMs + 1 }, want: "sleep_duration"},
+		{name: "negative duration", edit: func(m *health.SleepData) { m.SleepDuration = -1 }, want: "sleep_duration"},
+		{name: "lowercase quality", edit: func(m *health.SleepData) { m.SleepQuality = "good" }, want: "sleep_quality"},
+		{name: "timestamp for date", edit: func(m *health.SleepData) { m.RecordedDate = "2026-10-01T23:00:00Z" }, want: "recorded_date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid()
+			tt.edit(data)
+			got := SleepData(data)
+			if fields(got) != tt.want {
+				t.Errorf("SleepData() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeartRateData(t *testing.T) {
+	valid := func() *health.HeartRateData {
+		return &health.HeartRateData{UserId: "u1", HeartRate: 64, RecordedTimestamp: "2026-10-01T08:00:00Z"}
+	}
+
+	tests := []struct {
+		name string
+		edit func(*health.HeartRateData)
+		want string
+	}{
+		{name: "valid", edit: func(*health.HeartRateData) {}},
+		{name: "empty", edit: func(m *health.HeartRateData) { *m = health.HeartRateData{} }, want: "user_id,heart_rate,recorded_timestamp"},
+		{name: "lowest", edit: func(m *health.HeartRateData) { m.HeartRate = MinHeartRate }},
+		{name: "below range", edit: func(m *health.HeartRateData) { m.HeartRate = MinHeartRate - 1 }, want: "heart_rate"},
+		{name: "above range", edit: func(m *health.HeartRateData) { m.HeartRate = MaxHeartRate + 1 }, want: "heart_rate"},
+		{name: "timestamp format", edit: func(m *health.HeartRateData) { m.RecordedTimestamp = "2026-10-01 08:00" }, want: "recorded_timestamp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid()
+			tt.edit(data)
+			got := HeartRateData(data)
+			if fields(got) != tt.want {
+				t.Errorf("HeartRateData() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypedDataValue(t *testing.T) {
+	pack := func(m proto.Message) *anypb.Any {
+		value, err := anypb.New(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+
+	tests := []struct {
+		name   string
+		value  *anypb.Any
+		fields []string
+		want   string
+	}{
+		{name: "sleep", value: pack(&health.SleepData{SleepDuration: 7 * 3600 * 1000, SleepQuality: "Average"})},
+		{name: "sleep without user or date", value: pack(&health.SleepData{SleepDuration: 1, SleepQuality: "Poor"})},
+		{name: "sleep out of range", value: pack(&health.SleepData{SleepDuration: MaxSleepDurationMs + 1, SleepQuality: "Good"}), want: "data_value.sleep_duration"},
+		{name: "sleep quality", value: pack(&health.SleepData{SleepDuration: 1}), want: "data_value.sleep_quality"},
+		{name: "heart rate", value: pack(&health.HeartRateData{HeartRate: 180})},
+		{name: "heart rate out of range", value: pack(&health.HeartRateData{HeartRate: 400}), want: "data_value.heart_rate"},
+		{name: "patch checks the whole reading", value: pack(&health.SleepData{SleepDuration: 1}), fields: []string{"data_value"}, want: "data_value.sleep_quality"},
+		{name: "patch of other fields", value: pack(&health.HeartRateData{}), fields: []string{"data_type"}},
+		{name: "corrupt sleep", value: &anypb.Any{TypeUrl: pack(&health.SleepData{}).TypeUrl, Value: []byte{0xff}}, want: "data_value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LifestyleData(&health.LifestyleData{
+				UserId: "u1", DataType: "sleep", DataValue: tt.value, RecordedDate: "2026-10-01",
+			}, tt.fields...)
+			if fields(got) != tt.want {
+				t.Errorf("LifestyleData() = %v, want errors of %q", got, tt.want)
+			}
+
+			got = WearableData(&health.WearableData{
+				UserId: "u1", DeviceType: "smartwatch", DataType: "sleep", DataValue: tt.value,
+				RecordedTimestamp: "2026-10-01T08:00:00Z",
+			}, tt.fields...)
+			if fields(got) != tt.want {
+				t.Errorf("WearableData() = %v, want errors of %q", got, tt.want)
+			}
+		})
+	}
+}